@@ -0,0 +1,94 @@
+package analytics
+
+import (
+	"github.com/urfave/cli"
+
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+
+	// Flags shared by every view
+	flags := []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "days, d",
+			Usage: "The number of days of history to show",
+			Value: 90,
+		},
+		cli.Uint64Flag{
+			Name:  "interval, i",
+			Usage: "Keep at most one snapshot per this many minutes (0 to keep every snapshot)",
+			Value: 1440,
+		},
+		cli.StringFlag{
+			Name:  "csv, c",
+			Usage: "Export the data to this CSV file instead of printing it",
+		},
+	}
+
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "View the history of your node and its minipools",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "node",
+				Aliases:   []string{"n"},
+				Usage:     "Show the node's RPL stake, collateral ratio, RPL price and rETH rate over time",
+				UsageText: "poolseapool analytics node [options]",
+				Flags:     flags,
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getNodeAnalytics(c)
+
+				},
+			},
+
+			{
+				Name:      "minipools",
+				Aliases:   []string{"m"},
+				Usage:     "Show the balance and APR of each of the node's staking minipools",
+				UsageText: "poolseapool analytics minipools [options]",
+				Flags:     flags,
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getMinipoolAnalytics(c)
+
+				},
+			},
+
+			{
+				Name:      "rewards",
+				Aliases:   []string{"r"},
+				Usage:     "Show the RPL and Smoothing Pool ETH rewards the node earned in each rewards interval",
+				UsageText: "poolseapool analytics rewards [options]",
+				Flags:     flags,
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getRewardsAnalytics(c)
+
+				},
+			},
+		},
+	})
+}
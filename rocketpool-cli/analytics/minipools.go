@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+)
+
+func getMinipoolAnalytics(c *cli.Context) error {
+
+	// Get the snapshots
+	response, err := getSnapshots(c)
+	if err != nil {
+		return err
+	}
+	if len(response.Snapshots) == 0 {
+		fmt.Printf("There are no analytics snapshots from the last %d day(s).\n", c.Uint64("days"))
+		return nil
+	}
+
+	// Export every minipool record
+	if c.String("csv") != "" {
+		rows := [][]string{}
+		for _, snapshot := range response.Snapshots {
+			for _, mp := range snapshot.Minipools {
+				rows = append(rows, []string{
+					formatTime(snapshot.Time),
+					mp.Address.Hex(),
+					mp.Status,
+					formatFloat(mp.NodeDeposit),
+					formatFloat(mp.UserDeposit),
+					formatFloat(mp.BeaconBalance),
+					formatFloat(mp.ExecutionBalance),
+				})
+			}
+		}
+		header := []string{"time", "minipool", "status", "node_deposit", "user_deposit", "beacon_balance", "execution_balance"}
+		return writeCsv(c.String("csv"), header, rows)
+	}
+
+	// Print the performance of each minipool
+	if len(response.Minipools) == 0 {
+		fmt.Printf("None of the node's minipools were staking in the last %d day(s).\n", c.Uint64("days"))
+		return nil
+	}
+	fmt.Printf("%-42s  %-16s  %-16s  %18s  %10s\n", "Minipool", "From", "To", "Balance Change", "APR")
+	for _, mp := range response.Minipools {
+		fmt.Printf("%-42s  %-16s  %-16s  %+18.6f  %9.2f%%\n", mp.Address.Hex(), mp.Start.Format(TimeFormat), mp.End.Format(TimeFormat), mp.EndBalance-mp.StartBalance, mp.Apr)
+	}
+	fmt.Println()
+	fmt.Println("The APR is based on the change in each minipool's combined Beacon Chain and execution layer balance relative to its total deposit.")
+	fmt.Println("Distributing a minipool's balance during the period lowers it, since the distributed ETH is no longer counted.")
+	return nil
+
+}
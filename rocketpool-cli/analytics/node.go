@@ -0,0 +1,62 @@
+package analytics
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli"
+)
+
+func getNodeAnalytics(c *cli.Context) error {
+
+	// Get the snapshots
+	response, err := getSnapshots(c)
+	if err != nil {
+		return err
+	}
+	snapshots := response.Snapshots
+	if len(snapshots) == 0 {
+		fmt.Printf("There are no analytics snapshots from the last %d day(s).\n", c.Uint64("days"))
+		return nil
+	}
+
+	// Export them
+	if c.String("csv") != "" {
+		rows := make([][]string, 0, len(snapshots))
+		for _, snapshot := range snapshots {
+			rows = append(rows, []string{
+				formatTime(snapshot.Time),
+				strconv.FormatUint(snapshot.Block, 10),
+				formatFloat(snapshot.Node.RplStake),
+				formatFloat(snapshot.Node.EffectiveRplStake),
+				formatFloat(snapshot.Node.CollateralRatio),
+				formatFloat(snapshot.Node.RplPrice),
+				formatFloat(snapshot.Node.RethRate),
+				formatFloat(snapshot.Node.EthBalance),
+				formatFloat(snapshot.Node.RplBalance),
+				strconv.FormatUint(snapshot.Node.MinipoolCount, 10),
+			})
+		}
+		header := []string{"time", "block", "rpl_stake", "effective_rpl_stake", "collateral_ratio", "rpl_price", "reth_rate", "eth_balance", "rpl_balance", "minipool_count"}
+		return writeCsv(c.String("csv"), header, rows)
+	}
+
+	// Print them
+	fmt.Printf("%-16s  %16s  %16s  %10s  %12s  %10s\n", "Time", "RPL Stake", "Effective Stake", "Collateral", "RPL Price", "rETH Rate")
+	for _, snapshot := range snapshots {
+		node := snapshot.Node
+		fmt.Printf("%-16s  %16.6f  %16.6f  %9.2f%%  %12.6f  %10.6f\n", snapshot.Time.Format(TimeFormat), node.RplStake, node.EffectiveRplStake, node.CollateralRatio*100, node.RplPrice, node.RethRate)
+	}
+
+	// Print the change over the period
+	first := snapshots[0].Node
+	last := snapshots[len(snapshots)-1].Node
+	fmt.Println()
+	fmt.Printf("Since %s:\n", snapshots[0].Time.Format(TimeFormat))
+	fmt.Printf("\tRPL stake:           %+.6f RPL\n", last.RplStake-first.RplStake)
+	fmt.Printf("\tEffective RPL stake: %+.6f RPL\n", last.EffectiveRplStake-first.EffectiveRplStake)
+	fmt.Printf("\tCollateral ratio:    %+.2f%%\n", (last.CollateralRatio-first.CollateralRatio)*100)
+	fmt.Printf("\trETH rate:           %+.6f ETH\n", last.RethRate-first.RethRate)
+	return nil
+
+}
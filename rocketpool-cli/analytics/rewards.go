@@ -0,0 +1,53 @@
+package analytics
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli"
+)
+
+func getRewardsAnalytics(c *cli.Context) error {
+
+	// Get the rewards
+	response, err := getSnapshots(c)
+	if err != nil {
+		return err
+	}
+	records := response.Rewards
+	if len(records) == 0 {
+		fmt.Printf("There are no recorded rewards from intervals that ended in the last %d day(s).\n", c.Uint64("days"))
+		return nil
+	}
+
+	// Export them
+	if c.String("csv") != "" {
+		rows := make([][]string, 0, len(records))
+		for _, record := range records {
+			rows = append(rows, []string{
+				strconv.FormatUint(record.Interval, 10),
+				formatTime(record.Start),
+				formatTime(record.End),
+				formatFloat(record.CollateralRpl),
+				formatFloat(record.OracleDaoRpl),
+				formatFloat(record.SmoothingPoolEth),
+			})
+		}
+		header := []string{"interval", "start", "end", "collateral_rpl", "oracle_dao_rpl", "smoothing_pool_eth"}
+		return writeCsv(c.String("csv"), header, rows)
+	}
+
+	// Print them
+	var totalRpl, totalEth float64
+	fmt.Printf("%-8s  %-16s  %16s  %16s  %18s\n", "Interval", "Ended", "Collateral RPL", "Oracle DAO RPL", "Smoothing Pool ETH")
+	for _, record := range records {
+		fmt.Printf("%-8d  %-16s  %16.6f  %16.6f  %18.6f\n", record.Interval, record.End.Format(TimeFormat), record.CollateralRpl, record.OracleDaoRpl, record.SmoothingPoolEth)
+		totalRpl += record.CollateralRpl + record.OracleDaoRpl
+		totalEth += record.SmoothingPoolEth
+	}
+	fmt.Println()
+	fmt.Printf("Total earned in these %d interval(s): %.6f RPL and %.6f ETH.\n", len(records), totalRpl, totalEth)
+	fmt.Println("Intervals are recorded by the node daemon once their rewards tree has been downloaded.")
+	return nil
+
+}
@@ -0,0 +1,77 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// The format of snapshot times in the output
+const TimeFormat = "2006-01-02 15:04"
+
+// Get the snapshots requested by the command's flags
+func getSnapshots(c *cli.Context) (api.AnalyticsSnapshotsResponse, error) {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return api.AnalyticsSnapshotsResponse{}, err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return api.AnalyticsSnapshotsResponse{}, err
+	}
+
+	// Get the snapshots
+	days := c.Uint64("days")
+	if days == 0 {
+		return api.AnalyticsSnapshotsResponse{}, fmt.Errorf("the number of days must be greater than 0")
+	}
+	return rp.AnalyticsSnapshots(days, c.Uint64("interval"))
+
+}
+
+// Write rows to a CSV file
+func writeCsv(path string, header []string, rows [][]string) error {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding CSV file path: %w", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("error writing CSV file: %w", err)
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("error writing CSV file: %w", err)
+	}
+	fmt.Printf("Wrote %d row(s) to %s.\n", len(rows), path)
+	return nil
+}
+
+// Format a value for a CSV file
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// Format a time for a CSV file
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,92 @@
+package minipool
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+func broadcastExits(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the exit files; directories are expanded to the JSON files they contain
+	exitFilePaths := []string{}
+	for _, path := range c.StringSlice("file") {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error reading exit file [%s]: %w", path, err)
+		}
+		if !info.IsDir() {
+			exitFilePaths = append(exitFilePaths, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return fmt.Errorf("error listing exit files in [%s]: %w", path, err)
+		}
+		exitFilePaths = append(exitFilePaths, matches...)
+	}
+	if len(exitFilePaths) == 0 {
+		fmt.Println("No signed exit message files were found.")
+		return nil
+	}
+
+	// Load and validate the exit messages
+	exitFiles := make([]signedExitMessageFile, len(exitFilePaths))
+	for i, path := range exitFilePaths {
+		fileBytes, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading exit file [%s]: %w", path, err)
+		}
+		err = json.Unmarshal(fileBytes, &exitFiles[i])
+		if err != nil {
+			return fmt.Errorf("error deserializing exit file [%s]: %w", path, err)
+		}
+		if _, err := cliutils.ValidateSignature("signature", exitFiles[i].Signature); err != nil {
+			return fmt.Errorf("exit file [%s] is invalid: %w", path, err)
+		}
+	}
+
+	// Show a warning message
+	fmt.Printf("%sNOTE:\n", colorYellow)
+	fmt.Println("You are about to broadcast pre-signed voluntary exits. This will tell each validator to stop all activities on the Beacon Chain.")
+	fmt.Printf("Please continue to run your validators until each one you've exited has been processed by the exit queue.\n\n%s", colorReset)
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.ConfirmWithIAgree(fmt.Sprintf("Are you sure you want to broadcast %d exit message(s)? This action cannot be undone!", len(exitFiles)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Broadcast the exit messages
+	for i, exitFile := range exitFiles {
+		signature, _ := cliutils.ValidateSignature("signature", exitFile.Signature)
+		if _, err := rp.BroadcastExitMessage(exitFile.Message.ValidatorIndex, exitFile.Message.Epoch, signature); err != nil {
+			fmt.Printf("Could not broadcast the exit for validator %d from %s: %s.\n", exitFile.Message.ValidatorIndex, exitFilePaths[i], err)
+		} else {
+			fmt.Printf("Successfully broadcast the exit for validator %d from %s.\n", exitFile.Message.ValidatorIndex, exitFilePaths[i])
+		}
+	}
+
+	// Return
+	return nil
+
+}
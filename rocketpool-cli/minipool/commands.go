@@ -1,6 +1,8 @@
 package minipool
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 
 	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
@@ -38,6 +40,34 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "events",
+				Usage:     "Show the lifecycle transitions of the node's minipools recorded by the node daemon",
+				UsageText: "Poolsea minipool events [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "minipool, m",
+						Usage: "Only show the transitions of this minipool",
+					},
+					cli.UintFlag{
+						Name:  "limit, l",
+						Usage: "Only show this many of the most recent transitions (0 shows all of them)",
+						Value: 50,
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getEvents(c)
+
+				},
+			},
+
 			{
 				Name:      "stake",
 				Aliases:   []string{"t"},
@@ -354,6 +384,82 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-exits",
+				Aliases:   []string{"ee"},
+				Usage:     "Pre-sign voluntary exits for staking minipools and save them to files, so they can be broadcast later without the node wallet",
+				UsageText: "Poolsea minipool export-exits [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm exporting the exit messages",
+					},
+					cli.StringFlag{
+						Name:  "minipool, m",
+						Usage: "The minipool/s to export exit messages for (address or 'all')",
+					},
+					cli.Uint64Flag{
+						Name:  "epoch, e",
+						Usage: "The epoch to sign the exit messages for (defaults to the current epoch)",
+					},
+					cli.StringFlag{
+						Name:  "output-dir, o",
+						Usage: "The directory to save the exit message files to (defaults to the current directory)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("minipool") != "" && c.String("minipool") != "all" {
+						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return exportExits(c)
+
+				},
+			},
+
+			{
+				Name:      "broadcast-exits",
+				Aliases:   []string{"be"},
+				Usage:     "Broadcast voluntary exits that were previously exported with `export-exits`",
+				UsageText: "Poolsea minipool broadcast-exits --file path [--file path ...] [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm broadcasting the exit messages",
+					},
+					cli.StringSliceFlag{
+						Name:  "file, f",
+						Usage: "A signed exit message file, or a directory of them, to broadcast (can be specified multiple times)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if len(c.StringSlice("file")) == 0 {
+						return fmt.Errorf("Please specify at least one exit message file with --file.")
+					}
+
+					// Run
+					return broadcastExits(c)
+
+				},
+			},
+
 			{
 				Name:      "close",
 				Aliases:   []string{"c"},
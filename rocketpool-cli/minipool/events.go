@@ -0,0 +1,72 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+func getEvents(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the recorded transitions
+	response, err := rp.MinipoolEvents()
+	if err != nil {
+		return err
+	}
+
+	// Filter them by minipool
+	transitions := []lifecycle.Transition{}
+	if c.String("minipool") != "" {
+		if !common.IsHexAddress(c.String("minipool")) {
+			return fmt.Errorf("Invalid minipool address '%s'.", c.String("minipool"))
+		}
+		address := common.HexToAddress(c.String("minipool"))
+		for _, transition := range response.Transitions {
+			if transition.Minipool == address {
+				transitions = append(transitions, transition)
+			}
+		}
+	} else {
+		transitions = response.Transitions
+	}
+
+	// Only keep the most recent ones
+	limit := c.Uint("limit")
+	if limit > 0 && uint(len(transitions)) > limit {
+		transitions = transitions[uint(len(transitions))-limit:]
+	}
+
+	if len(transitions) == 0 {
+		fmt.Println("No minipool lifecycle transitions have been recorded yet. They are recorded by the node daemon as it runs.")
+		return nil
+	}
+
+	// Print them
+	for _, transition := range transitions {
+		from := string(transition.From)
+		if from == "" {
+			from = "(first seen)"
+		}
+		fmt.Printf("%s  block %-10d  %s  %s -> %s\n", transition.Time.Format(TimeFormat), transition.Block, transition.Minipool.Hex(), from, transition.To)
+	}
+	return nil
+
+}
@@ -0,0 +1,158 @@
+package minipool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Config
+const exitFileNameFormat string = "exit-%s.json"
+
+// A signed voluntary exit, in the format used by the Beacon API and other staking tools
+type signedExitMessageFile struct {
+	Message struct {
+		Epoch          uint64 `json:"epoch,string"`
+		ValidatorIndex uint64 `json:"validator_index,string"`
+	} `json:"message"`
+	Signature string `json:"signature"`
+}
+
+func exportExits(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get minipool statuses
+	status, err := rp.MinipoolStatus()
+	if err != nil {
+		return err
+	}
+
+	// Get minipools with a validator on the Beacon Chain
+	exitableMinipools := []api.MinipoolDetails{}
+	for _, minipool := range status.Minipools {
+		if (minipool.Status.Status == types.Staking || (minipool.Status.Status == types.Dissolved && !minipool.Finalised)) && minipool.Validator.Exists {
+			exitableMinipools = append(exitableMinipools, minipool)
+		}
+	}
+
+	// Check for exitable minipools
+	if len(exitableMinipools) == 0 {
+		fmt.Println("No minipools have a validator that can be pre-signed for exiting.")
+		return nil
+	}
+
+	// Get selected minipools
+	var selectedMinipools []api.MinipoolDetails
+	if c.String("minipool") == "" {
+
+		// Prompt for minipool selection
+		options := make([]string, len(exitableMinipools)+1)
+		options[0] = "All available minipools"
+		for mi, minipool := range exitableMinipools {
+			options[mi+1] = fmt.Sprintf("%s (validator %d)", minipool.Address.Hex(), minipool.Validator.Index)
+		}
+		selected, _ := cliutils.Select("Please select a minipool to export a signed exit message for:", options)
+
+		// Get minipools
+		if selected == 0 {
+			selectedMinipools = exitableMinipools
+		} else {
+			selectedMinipools = []api.MinipoolDetails{exitableMinipools[selected-1]}
+		}
+
+	} else {
+
+		// Get matching minipools
+		if c.String("minipool") == "all" {
+			selectedMinipools = exitableMinipools
+		} else {
+			selectedAddress := common.HexToAddress(c.String("minipool"))
+			for _, minipool := range exitableMinipools {
+				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
+					selectedMinipools = []api.MinipoolDetails{minipool}
+					break
+				}
+			}
+			if selectedMinipools == nil {
+				return fmt.Errorf("The minipool %s does not have a validator that can be pre-signed for exiting.", selectedAddress.Hex())
+			}
+		}
+
+	}
+
+	// Create the output directory
+	outputDir := c.String("output-dir")
+	if outputDir == "" {
+		outputDir = "."
+	}
+	err = os.MkdirAll(outputDir, 0700)
+	if err != nil {
+		return fmt.Errorf("error creating output directory [%s]: %w", outputDir, err)
+	}
+
+	// Show a warning message
+	fmt.Printf("%sNOTE:\n", colorYellow)
+	fmt.Println("The exported files are signed voluntary exits. Anyone who holds one of them can exit the corresponding validator at any time, without your wallet or mnemonic.")
+	fmt.Printf("Please store them somewhere safe (such as offline storage) and treat them as carefully as your keys.\n\n%s", colorReset)
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to export signed exit messages for %d minipool(s) to %s?", len(selectedMinipools), outputDir))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Export exit messages
+	for _, minipool := range selectedMinipools {
+		response, err := rp.GetSignedExitMessage(minipool.Address, c.Uint64("epoch"))
+		if err != nil {
+			fmt.Printf("Could not get a signed exit message for minipool %s: %s.\n", minipool.Address.Hex(), err)
+			continue
+		}
+
+		// Serialize the message
+		var exitFile signedExitMessageFile
+		exitFile.Message.Epoch = response.Epoch
+		exitFile.Message.ValidatorIndex = response.ValidatorIndex
+		exitFile.Signature = "0x" + response.Signature.Hex()
+		fileBytes, err := json.MarshalIndent(exitFile, "", "  ")
+		if err != nil {
+			fmt.Printf("Could not serialize the signed exit message for minipool %s: %s.\n", minipool.Address.Hex(), err)
+			continue
+		}
+
+		// Write it to disk
+		exitFilePath := filepath.Join(outputDir, fmt.Sprintf(exitFileNameFormat, minipool.Address.Hex()))
+		err = os.WriteFile(exitFilePath, fileBytes, 0600)
+		if err != nil {
+			fmt.Printf("Could not save the signed exit message for minipool %s: %s.\n", minipool.Address.Hex(), err)
+			continue
+		}
+		fmt.Printf("Saved the signed exit message for minipool %s (validator %d, epoch %d) to %s.\n", minipool.Address.Hex(), response.ValidatorIndex, response.Epoch, exitFilePath)
+	}
+
+	// Return
+	return nil
+
+}
@@ -3,6 +3,7 @@ package minipool
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/Seb369888/poolsea-go/types"
 	"github.com/Seb369888/poolsea-go/utils/eth"
@@ -124,6 +125,21 @@ func getStatus(c *cli.Context) error {
 
 	fmt.Println("")
 
+	// Print the basis of the queue estimates
+	if status.DepositPoolInflowRate != nil {
+		inflowPerDay := big.NewInt(0).Mul(status.DepositPoolInflowRate, big.NewInt(int64((24 * time.Hour).Seconds())))
+		fmt.Printf("Queue assignment estimates are based on an average deposit pool inflow of %.6f ETH per day over the last %.0f day(s).\n", math.RoundDown(eth.WeiToEth(inflowPerDay), 6), status.QueueEtaLookback.Hours()/24)
+		fmt.Println("")
+	}
+
+	// Note if the queue estimates or lifecycle stages couldn't be determined
+	if status.QueueEstimateError != "" {
+		fmt.Printf("%sNOTE: the queue assignment estimates of your minipools aren't shown because they couldn't be determined: %s%s\n\n", colorYellow, status.QueueEstimateError, colorReset)
+	}
+	if status.LifecycleStageError != "" {
+		fmt.Printf("%sNOTE: the lifecycle stages of your minipools aren't shown because they couldn't be determined: %s%s\n\n", colorYellow, status.LifecycleStageError, colorReset)
+	}
+
 	// Print actionable minipool details
 	if len(refundableMinipools) > 0 {
 		fmt.Printf("%d minipool(s) have refunds available:\n", len(refundableMinipools))
@@ -160,12 +176,29 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 		fmt.Printf("%sInfractions:           %d%s\n", colorRed, minipool.Penalties, colorReset)
 	}
 	fmt.Printf("Status updated:        %s\n", minipool.Status.StatusTime.Format(TimeFormat))
+	if minipool.Stage != "" {
+		if minipool.StageWaitUntil.IsZero() {
+			fmt.Printf("Lifecycle stage:       %s\n", minipool.Stage)
+		} else {
+			fmt.Printf("Lifecycle stage:       %s (until %s)\n", minipool.Stage, minipool.StageWaitUntil.Format(TimeFormat))
+		}
+	}
 	fmt.Printf("Node fee:              %f%%\n", minipool.Node.Fee*100)
 	fmt.Printf("Node deposit:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
 
 	// Queue position
 	if minipool.Queue.Position != 0 {
 		fmt.Printf("Queue position:        %d\n", minipool.Queue.Position)
+		if minipool.QueueEstimate.EthRequired != nil {
+			estimate := minipool.QueueEstimate
+			if estimate.Shortfall.Sign() == 0 {
+				fmt.Printf("Estimated assignment:  at the next deposit (the deposit pool already covers it)\n")
+			} else if estimate.Known {
+				fmt.Printf("Estimated assignment:  in %s (%.6f ETH still needed)\n", estimate.TimeToAssignment.Round(time.Minute), math.RoundDown(eth.WeiToEth(estimate.Shortfall), 6))
+			} else {
+				fmt.Printf("Estimated assignment:  unknown (%.6f ETH still needed, too few recent deposits)\n", math.RoundDown(eth.WeiToEth(estimate.Shortfall), 6))
+			}
+		}
 	}
 
 	// RP ETH deposit details - prelaunch & staking minipools
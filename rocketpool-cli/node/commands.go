@@ -113,6 +113,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "force",
 						Usage: "Force update the withdrawal address, bypassing the 'pending' state that requires a confirmation transaction from the new address",
 					},
+					cli.StringFlag{
+						Name:  "offline",
+						Usage: "Save the transaction to this file unsigned, so it can be signed on an offline machine with `wallet sign-offline` instead of being sent",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -232,6 +236,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "swap, s",
 						Usage: "Automatically confirm swapping old RPL before staking",
 					},
+					cli.StringFlag{
+						Name:  "offline",
+						Usage: "Save the transaction to this file unsigned, so it can be signed on an offline machine with `wallet sign-offline` instead of being sent",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -671,6 +679,70 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return signMessage(c)
 				},
 			},
+
+			{
+				Name:      "broadcast",
+				Usage:     "Submit a transaction that was signed offline with `wallet sign-offline`",
+				UsageText: "Poolsea node broadcast [options] signed-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm submitting the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					signedFile := c.Args().Get(0)
+
+					// Run
+					return broadcastTransaction(c, signedFile)
+
+				},
+			},
+
+			{
+				Name:      "export-ledger",
+				Usage:     "Export the node's reward claims, distributions, beacon withdrawals and gas costs with the RPL price at the time of each",
+				UsageText: "poolseapool node export-ledger --from date [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "The first day to include, as YYYY-MM-DD (UTC)",
+					},
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "The last day to include, as YYYY-MM-DD (UTC); defaults to today",
+					},
+					cli.StringFlag{
+						Name:  "format, f",
+						Usage: "The output format: 'csv' or 'json'",
+						Value: "csv",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "Write the ledger to this file instead of printing it",
+					},
+					cli.BoolFlag{
+						Name:  "withdrawals",
+						Usage: "Also scan the beacon chain for withdrawals to the node's minipools; this reads every beacon block in the range, so it is limited to ranges of up to 31 days",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return exportLedger(c)
+
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,166 @@
+package node
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// The format of the --from and --to dates
+const ledgerDateFormat = "2006-01-02"
+
+func exportLedger(c *cli.Context) error {
+
+	// Get the date range; the end date is inclusive
+	if c.String("from") == "" {
+		return fmt.Errorf("please provide the first day to include with --from")
+	}
+	from, err := time.Parse(ledgerDateFormat, c.String("from"))
+	if err != nil {
+		return fmt.Errorf("invalid --from date '%s', expected YYYY-MM-DD: %w", c.String("from"), err)
+	}
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if c.String("to") != "" {
+		to, err = time.Parse(ledgerDateFormat, c.String("to"))
+		if err != nil {
+			return fmt.Errorf("invalid --to date '%s', expected YYYY-MM-DD: %w", c.String("to"), err)
+		}
+	}
+	to = to.Add(24 * time.Hour)
+	if !to.After(from) {
+		return fmt.Errorf("the --to date must not be before the --from date")
+	}
+	format := strings.ToLower(c.String("format"))
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("invalid format '%s', expected 'csv' or 'json'", c.String("format"))
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Build the ledger
+	includeWithdrawals := c.Bool("withdrawals")
+	if includeWithdrawals {
+		fmt.Fprintln(os.Stderr, "Scanning the beacon chain for withdrawals to your minipools; this can take a while...")
+	}
+	response, err := rp.NodeExportLedger(from, to, includeWithdrawals)
+	if err != nil {
+		return err
+	}
+
+	// Get the output
+	var output io.Writer = os.Stdout
+	path := c.String("output")
+	if path != "" {
+		path, err = homedir.Expand(path)
+		if err != nil {
+			return fmt.Errorf("error expanding output file path: %w", err)
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("error creating output file: %w", err)
+		}
+		defer file.Close()
+		output = file
+	}
+
+	// Write the ledger
+	if format == "json" {
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "    ")
+		err = encoder.Encode(response.Entries)
+	} else {
+		err = writeLedgerCsv(output, response.Entries)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing ledger: %w", err)
+	}
+	if path != "" {
+		fmt.Printf("Wrote %d ledger entries from blocks %d to %d to %s.\n", len(response.Entries), response.FromBlock, response.ToBlock, path)
+	}
+	return nil
+
+}
+
+// Write the ledger entries as CSV, with amounts in ETH and RPL; withdrawn amounts aren't part of the total value
+func writeLedgerCsv(output io.Writer, entries []api.NodeLedgerEntry) error {
+	writer := csv.NewWriter(output)
+	header := []string{"Time", "Block", "Type", "Source", "Transaction", "ETH", "RPL", "RPL Price (ETH)", "Total Value (ETH)", "Withdrawn (ETH)"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		txHash := ""
+		if entry.TxHash != (common.Hash{}) {
+			txHash = entry.TxHash.Hex()
+		}
+		price := ""
+		value := ""
+		withdrawn := ""
+		if entry.WithdrawnAmount != nil {
+			withdrawn = formatWei(entry.WithdrawnAmount)
+		}
+		if entry.RplPrice != nil {
+			price = formatWei(entry.RplPrice)
+			total := new(big.Int).Mul(getAmount(entry.RplAmount), entry.RplPrice)
+			total.Quo(total, big.NewInt(1e18))
+			value = formatWei(total.Add(total, getAmount(entry.EthAmount)))
+		}
+		row := []string{
+			entry.Time.UTC().Format(time.RFC3339),
+			strconv.FormatUint(entry.Block, 10),
+			entry.Type,
+			entry.Source.Hex(),
+			txHash,
+			formatWei(getAmount(entry.EthAmount)),
+			formatWei(getAmount(entry.RplAmount)),
+			price,
+			value,
+			withdrawn,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Get an amount, treating missing ones as zero
+func getAmount(amount *big.Int) *big.Int {
+	if amount == nil {
+		return big.NewInt(0)
+	}
+	return amount
+}
+
+// Format a wei amount as an exact decimal amount
+func formatWei(amount *big.Int) string {
+	value := new(big.Rat).SetFrac(amount, big.NewInt(1e18)).FloatString(18)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
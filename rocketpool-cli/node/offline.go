@@ -0,0 +1,71 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+func broadcastTransaction(c *cli.Context, signedFile string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Load the signed transaction
+	path, err := homedir.Expand(signedFile)
+	if err != nil {
+		return fmt.Errorf("error expanding signed transaction file path: %w", err)
+	}
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading signed transaction file: %w", err)
+	}
+	var signedTx api.SignedOfflineTransaction
+	if err := json.Unmarshal(bytes, &signedTx); err != nil {
+		return fmt.Errorf("error parsing signed transaction file: %w", err)
+	}
+
+	// Prompt for confirmation
+	fmt.Printf("Description: %s\n", signedTx.Description)
+	fmt.Printf("From:        %s\n", signedTx.From.Hex())
+	fmt.Printf("Nonce:       %d\n", uint64(signedTx.Nonce))
+	fmt.Printf("TX hash:     %s\n\n", signedTx.TxHash.Hex())
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this transaction?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Broadcast the transaction
+	response, err := rp.BroadcastTransaction(signedTx.Payload)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Submitting transaction...\n")
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The transaction was successfully completed.")
+	return nil
+
+}
@@ -42,7 +42,7 @@ func nodeStakeRpl(c *cli.Context) error {
 
 	// Check for fixed-supply RPL balance
 	rplBalance := *(status.AccountBalances.RPL)
-	if status.AccountBalances.FixedSupplyRPL.Cmp(big.NewInt(0)) > 0 {
+	if status.AccountBalances.FixedSupplyRPL.Cmp(big.NewInt(0)) > 0 && c.String("offline") == "" {
 
 		// Confirm swapping RPL
 		if c.Bool("swap") || cliutils.Confirm(fmt.Sprintf("The node has a balance of %.6f old RPL. Would you like to swap it for new RPL before staking?", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))) {
@@ -292,6 +292,19 @@ func nodeStakeRpl(c *cli.Context) error {
 			return nil
 		}
 
+		// Export the approval for offline signing
+		if c.String("offline") != "" {
+			response, err := rp.BuildNodeStakeRplApprove(status.AccountAddress, maxApproval)
+			if err != nil {
+				return err
+			}
+			if err := cliutils.SaveOfflineTransaction(c.String("offline"), response.Transaction); err != nil {
+				return err
+			}
+			fmt.Println("\nOnce the approval has been broadcast and confirmed, please re-run this command to export the stake transaction.")
+			return nil
+		}
+
 		// Approve RPL for staking
 		response, err := rp.NodeStakeRplApprove(maxApproval)
 		if err != nil {
@@ -340,6 +353,15 @@ func nodeStakeRpl(c *cli.Context) error {
 		return nil
 	}
 
+	// Export the stake for offline signing
+	if c.String("offline") != "" {
+		response, err := rp.BuildNodeStakeRpl(status.AccountAddress, amountWei)
+		if err != nil {
+			return err
+		}
+		return cliutils.SaveOfflineTransaction(c.String("offline"), response.Transaction)
+	}
+
 	// Stake RPL
 	stakeResponse, err := rp.NodeStakeRpl(amountWei)
 	if err != nil {
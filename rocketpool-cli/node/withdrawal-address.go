@@ -70,7 +70,7 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string) error {
 		return err
 	}
 
-	if confirm {
+	if confirm && c.String("offline") == "" {
 		// Prompt for a test transaction
 		if cliutils.Confirm("Would you like to send a test transaction to make sure you have the correct address?") {
 			inputAmount := cliutils.Prompt(fmt.Sprintf("Please enter an amount of ETH to send to %s:", withdrawalAddressString), "^\\d+(\\.\\d+)?$", "Invalid amount")
@@ -122,6 +122,19 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string) error {
 		return nil
 	}
 
+	// Export the withdrawal address change for offline signing
+	if c.String("offline") != "" {
+		status, err := rp.NodeStatus()
+		if err != nil {
+			return err
+		}
+		response, err := rp.BuildSetNodeWithdrawalAddress(status.AccountAddress, withdrawalAddress, confirm)
+		if err != nil {
+			return err
+		}
+		return cliutils.SaveOfflineTransaction(c.String("offline"), response.Transaction)
+	}
+
 	// Set node's withdrawal address
 	response, err := rp.SetNodeWithdrawalAddress(withdrawalAddress, confirm)
 	if err != nil {
@@ -188,248 +188,31 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 
 					{
-						Name:    "setting",
-						Aliases: []string{"s"},
-						Usage:   "Make an oracle DAO setting proposal",
-						Subcommands: []cli.Command{
-
-							{
-								Name:      "members-quorum",
-								Aliases:   []string{"q"},
-								Usage:     "Propose updating the members.quorum setting - takes a percent, from 0 to 100",
-								UsageText: "poolseapool odao propose setting members-quorum value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-									quorumPercent, err := cliutils.ValidatePercentage("quorum percentage", c.Args().Get(0))
-									if err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingMembersQuorum(c, quorumPercent)
-
-								},
-							},
-							{
-								Name:      "members-rplbond",
-								Aliases:   []string{"b"},
-								Usage:     "Propose updating the members.rplbond setting - takes an RPL amount (e.g. 5000)",
-								UsageText: "poolseapool odao propose setting members-rplbond value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-									bondAmountEth, err := cliutils.ValidateEthAmount("RPL bond amount", c.Args().Get(0))
-									if err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingMembersRplBond(c, bondAmountEth)
-
-								},
-							},
-							{
-								Name:      "members-minipool-unbonded-max",
-								Aliases:   []string{"u"},
-								Usage:     "Propose updating the members.minipool.unbonded.max setting - takes a number (e.g 100)",
-								UsageText: "poolseapool odao propose setting members-minipool-unbonded-max value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-									unbondedMinipoolMax, err := cliutils.ValidateUint("maximum unbonded minipool count", c.Args().Get(0))
-									if err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax)
-
-								},
-							},
-							{
-								Name:      "proposal-cooldown",
-								Aliases:   []string{"c"},
-								Usage:     "Propose updating the proposal.cooldown.time setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting proposal-cooldown value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingProposalCooldown(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "proposal-vote-timespan",
-								Aliases:   []string{"v"},
-								Usage:     "Propose updating the proposal.vote.time setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting proposal-vote-timespan value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingProposalVoteTimespan(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "proposal-vote-delay-timespan",
-								Aliases:   []string{"d"},
-								Usage:     "Propose updating the proposal.vote.delay.time setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting proposal-vote-delay-timespan value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingProposalVoteDelayTimespan(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "proposal-execute-timespan",
-								Aliases:   []string{"x"},
-								Usage:     "Propose updating the proposal.execute.time setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting proposal-execute-timespan value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingProposalExecuteTimespan(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "proposal-action-timespan",
-								Aliases:   []string{"a"},
-								Usage:     "Propose updating the proposal.action.time setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting proposal-action-timespan value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingProposalActionTimespan(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "scrub-period",
-								Aliases:   []string{"s"},
-								Usage:     "Propose updating the minipool.scrub.period setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting scrub-period value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingScrubPeriod(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "promotion-scrub-period",
-								Aliases:   []string{"p"},
-								Usage:     "Propose updating the minipool.promotion.scrub.period setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting promotion-scrub-period value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingPromotionScrubPeriod(c, c.Args().Get(0))
-
-								},
-							},
-							{
-								Name:      "scrub-penalty-enabled",
-								Aliases:   []string{"spe"},
-								Usage:     "Propose updating the minipool.scrub.penalty.enabled setting - format is true / false",
-								UsageText: "poolseapool odao propose setting scrub-penalty-enabled value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-									enabled, err := cliutils.ValidateBool("enabled", c.Args().Get(0))
-									if err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingScrubPenaltyEnabled(c, enabled)
-
-								},
+						Name:      "setting",
+						Aliases:   []string{"s"},
+						Usage:     "Make an oracle DAO setting proposal; run without arguments to list the settings that can be changed",
+						UsageText: "poolseapool odao propose setting [options] [setting value]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the proposal",
 							},
-							{
-								Name:      "bond-reduction-window-start",
-								Aliases:   []string{"brws"},
-								Usage:     "Propose updating the minipool.bond.reduction.window.start setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting bond-reduction-window-start value",
-								Action: func(c *cli.Context) error {
-
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
-
-									// Run
-									return proposeSettingBondReductionWindowStart(c, c.Args().Get(0))
+						},
+						Action: func(c *cli.Context) error {
 
-								},
-							},
-							{
-								Name:      "bond-reduction-window-length",
-								Aliases:   []string{"brwl"},
-								Usage:     "Propose updating the minipool.bond.reduction.window.length setting - format is e.g. 1h30m45s",
-								UsageText: "poolseapool odao propose setting bond-reduction-window-length value",
-								Action: func(c *cli.Context) error {
+							// List the settings if none was given
+							if c.NArg() == 0 {
+								return listSettings()
+							}
 
-									// Validate args
-									if err := cliutils.ValidateArgCount(c, 1); err != nil {
-										return err
-									}
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 2); err != nil {
+								return err
+							}
 
-									// Run
-									return proposeSettingBondReductionWindowLength(c, c.Args().Get(0))
+							// Run
+							return proposeSetting(c, c.Args().Get(0), c.Args().Get(1))
 
-								},
-							},
 						},
 					},
 				},
@@ -564,6 +347,36 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						},
 					},
 
+					{
+						Name:      "vote-batch",
+						Aliases:   []string{"vb"},
+						Usage:     "Vote on several proposals at once, using a YAML file of proposal IDs and support values",
+						UsageText: "poolseapool odao proposals vote-batch [options] vote-file",
+						Description: "The vote file is a YAML list of votes, for example:\n\n" +
+							"- proposal: 12\n  support: true\n- proposal: 13\n  support: false",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the votes",
+							},
+							cli.StringFlag{
+								Name:  "offline",
+								Usage: "Save the votes unsigned instead of sending them, one file per proposal named after this file (e.g. votes.json becomes votes-12.json), so they can be signed on an offline machine with `wallet sign-offline`",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run
+							return voteOnProposalBatch(c, c.Args().Get(0))
+
+						},
+					},
+
 					{
 						Name:      "execute",
 						Aliases:   []string{"x"},
@@ -2,770 +2,57 @@ package odao
 
 import (
 	"fmt"
-	"time"
+	"strings"
 
-	"github.com/Seb369888/poolsea-go/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/services/gas"
 	"github.com/Seb369888/smartnode/shared/services/rocketpool"
 	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
 )
 
-func proposeSettingMembersQuorum(c *cli.Context, quorumPercent float64) error {
+func listSettings() error {
 
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingMembersQuorum(quorumPercent / 100)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingMembersQuorum(quorumPercent / 100)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a members.quorum setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingMembersRplBond(c *cli.Context, bondAmountEth float64) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingMembersRplBond(eth.EthToWei(bondAmountEth))
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingMembersRplBond(eth.EthToWei(bondAmountEth))
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a members.rplbond setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint64) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a members.minipool.unbonded.max setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(proposalCooldownTimespan)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingProposalCooldown(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingProposalCooldown(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a proposal.cooldown setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(proposalVoteTimespan)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingProposalVoteTimespan(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingProposalVoteTimespan(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a proposal.vote.time setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTimespan string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(proposalDelayTimespan)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingProposalVoteDelayTimespan(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingProposalVoteDelayTimespan(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a proposal.vote.delay.time setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTimespan string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(proposalExecuteTimespan)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingProposalExecuteTimespan(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingProposalExecuteTimespan(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a proposal.execute.time setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimespan string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(proposalActionTimespan)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingProposalActionTimespan(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingProposalActionTimespan(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a proposal.action.time setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingScrubPeriod(c *cli.Context, scrubPeriod string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(scrubPeriod)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingScrubPeriod(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingScrubPeriod(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a minipool.scrub.period setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingPromotionScrubPeriod(c *cli.Context, scrubPeriod string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(scrubPeriod)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingPromotionScrubPeriod(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+	fmt.Println("The following oracle DAO settings can be changed with `poolseapool odao propose setting <setting> <value>`:")
+	fmt.Println()
+	for _, setting := range rputils.TNDAOSettings {
+		name := setting.Name
+		if len(setting.Aliases) > 0 {
+			name = fmt.Sprintf("%s (%s)", name, strings.Join(setting.Aliases, ", "))
 		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingPromotionScrubPeriod(seconds)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a minipool.promotion.scrub.period setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingScrubPenaltyEnabled(c *cli.Context, enabled bool) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingScrubPenaltyEnabled(enabled)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+		fmt.Printf("%s\n", name)
+		fmt.Printf("\tPath:   %s\n", setting.Path)
+		fmt.Printf("\tValue:  %s\n", setting.GetFormatDescription())
+		if setting.Min != nil || setting.Max != nil {
+			min := "no minimum"
+			if setting.Min != nil {
+				min = setting.FormatValue(setting.Min)
+			}
+			max := "no maximum"
+			if setting.Max != nil {
+				max = setting.FormatValue(setting.Max)
+			}
+			fmt.Printf("\tRange:  %s to %s\n", min, max)
 		}
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingScrubPenaltyEnabled(enabled)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
+		fmt.Printf("\t%s\n\n", setting.Description)
 	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a minipool.scrub.penalty.enabled setting update proposal with ID %d.\n", response.ProposalId)
 	return nil
 
 }
 
-func proposeSettingBondReductionWindowStart(c *cli.Context, windowStart string) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClientFromCtx(c)
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
-	if err != nil {
-		return err
-	}
-
-	// Parse the timespan
-	timespan, err := time.ParseDuration(windowStart)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
-	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingBondReductionWindowStart(seconds)
-	if err != nil {
-		return err
-	}
-	if !canPropose.CanPropose {
-		fmt.Println("Cannot propose setting update:")
-		if canPropose.ProposalCooldownActive {
-			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
-		}
-		return nil
-	}
+func proposeSetting(c *cli.Context, settingName string, input string) error {
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
+	// Get the setting and its new value
+	setting, err := rputils.GetTNDAOSetting(settingName)
 	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
-		fmt.Println("Cancelled.")
-		return nil
+		return fmt.Errorf("%w; run `poolseapool odao propose setting` to list the available settings", err)
 	}
-
-	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingBondReductionWindowStart(seconds)
+	value, err := setting.ParseValue(input)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Submitting proposal...\n")
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully submitted a minipool.bond.reduction.window.start setting update proposal with ID %d.\n", response.ProposalId)
-	return nil
-
-}
-
-func proposeSettingBondReductionWindowLength(c *cli.Context, windowLength string) error {
-
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -779,15 +66,8 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, windowLength string
 		return err
 	}
 
-	// Parse the timespan
-	timespan, err := time.ParseDuration(windowLength)
-	if err != nil {
-		return fmt.Errorf("Error parsing time: %w\n", err)
-	}
-	seconds := uint64(timespan.Seconds())
-
 	// Check if proposal can be made
-	canPropose, err := rp.CanProposeTNDAOSettingBondReductionWindowLength(seconds)
+	canPropose, err := rp.CanProposeTNDAOSetting(setting.Name, value)
 	if err != nil {
 		return err
 	}
@@ -806,13 +86,13 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, windowLength string
 	}
 
 	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to submit this proposal?")) {
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to propose setting %s to %s?", setting.Path, setting.FormatValue(value)))) {
 		fmt.Println("Cancelled.")
 		return nil
 	}
 
 	// Submit proposal
-	response, err := rp.ProposeTNDAOSettingBondReductionWindowLength(seconds)
+	response, err := rp.ProposeTNDAOSetting(setting.Name, value)
 	if err != nil {
 		return err
 	}
@@ -824,7 +104,7 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, windowLength string
 	}
 
 	// Log & return
-	fmt.Printf("Successfully submitted a minipool.bond.reduction.window.length setting update proposal with ID %d.\n", response.ProposalId)
+	fmt.Printf("Successfully submitted a %s setting update proposal with ID %d.\n", setting.Path, response.ProposalId)
 	return nil
 
 }
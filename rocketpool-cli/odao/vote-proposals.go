@@ -0,0 +1,214 @@
+package odao
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// The outcome of a single vote in a batch
+type batchVoteOutcome struct {
+	vote   api.TNDAOProposalVote
+	result string
+}
+
+// Load a batch of votes from a YAML file
+func loadVoteBatch(path string) ([]api.TNDAOProposalVote, error) {
+
+	// Read the file
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding vote file path: %w", err)
+	}
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading vote file: %w", err)
+	}
+	var votes []api.TNDAOProposalVote
+	if err := yaml.UnmarshalStrict(bytes, &votes); err != nil {
+		return nil, fmt.Errorf("error parsing vote file: %w", err)
+	}
+
+	// Check the entries
+	if len(votes) == 0 {
+		return nil, fmt.Errorf("the vote file does not contain any votes")
+	}
+	seen := map[uint64]bool{}
+	for i, vote := range votes {
+		if vote.ProposalId == 0 {
+			return nil, fmt.Errorf("entry %d of the vote file is missing a valid proposal ID", i+1)
+		}
+		if seen[vote.ProposalId] {
+			return nil, fmt.Errorf("proposal %d is listed more than once in the vote file", vote.ProposalId)
+		}
+		seen[vote.ProposalId] = true
+	}
+	return votes, nil
+
+}
+
+func voteOnProposalBatch(c *cli.Context, voteFile string) error {
+
+	// Load the votes
+	votes, err := loadVoteBatch(voteFile)
+	if err != nil {
+		return err
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Check every vote before submitting any of them
+	canVote, err := rp.CanVoteOnTNDAOProposals(votes)
+	if err != nil {
+		return err
+	}
+	outcomes := make([]batchVoteOutcome, 0, len(votes))
+	validVotes := []api.TNDAOProposalVote{}
+	for i, vote := range votes {
+		check := canVote.Votes[i]
+		if check.CanVote {
+			validVotes = append(validVotes, vote)
+			continue
+		}
+		reason := "cannot be voted on"
+		if check.DoesNotExist {
+			reason = "the proposal does not exist"
+		} else if check.InvalidState {
+			reason = "the proposal is not active"
+		} else if check.AlreadyVoted {
+			reason = "the node has already voted on this proposal"
+		} else if check.JoinedAfterCreated {
+			reason = "the proposal was created before the node joined the oracle DAO"
+		}
+		outcomes = append(outcomes, batchVoteOutcome{vote: vote, result: "skipped: " + reason})
+	}
+
+	// Print the plan
+	fmt.Printf("%d of the %d vote(s) in %s can be submitted:\n", len(validVotes), len(votes), voteFile)
+	for _, vote := range validVotes {
+		fmt.Printf("\tProposal %d: %s\n", vote.ProposalId, getSupportLabel(vote.Support))
+	}
+	for _, outcome := range outcomes {
+		fmt.Printf("\tProposal %d: %s\n", outcome.vote.ProposalId, outcome.result)
+	}
+	fmt.Println()
+	if len(validVotes) == 0 {
+		fmt.Println("None of the votes can be submitted.")
+		return nil
+	}
+
+	// Assign max fees; each vote is its own transaction, so this is for the most expensive one
+	err = gas.AssignMaxFeeAndLimit(canVote.GasInfo, rp, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+	if len(validVotes) > 1 {
+		totalGas := uint64(0)
+		for i := range votes {
+			if canVote.Votes[i].CanVote {
+				totalGas += canVote.Votes[i].GasInfo.SafeGasLimit
+			}
+		}
+		maxFeeGwei, _, _ := rp.GetGasSettings()
+		fmt.Printf("Each vote is sent as its own transaction with its own gas limit. All %d votes together will use up to %d gas, or %.6f ETH at this max fee.\n\n", len(validVotes), totalGas, maxFeeGwei*float64(totalGas)/eth.WeiPerGwei)
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to submit %d vote(s)? Your votes cannot be changed later.", len(validVotes)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Export the votes for offline signing
+	if c.String("offline") != "" {
+		status, err := rp.NodeStatus()
+		if err != nil {
+			return err
+		}
+		response, err := rp.BuildVoteOnTNDAOProposals(status.AccountAddress, validVotes)
+		if err != nil {
+			return err
+		}
+		paths := make([]string, len(validVotes))
+		for i, vote := range validVotes {
+			paths[i] = getVoteTransactionPath(c.String("offline"), vote.ProposalId)
+		}
+		return cliutils.SaveOfflineTransactions(paths, response.Transactions)
+	}
+
+	// Submit the votes
+	response, err := rp.VoteOnTNDAOProposals(validVotes)
+	if err != nil {
+		return err
+	}
+
+	// Wait for each of them
+	fmt.Printf("Submitting votes...\n")
+	for _, result := range response.Votes {
+		vote := api.TNDAOProposalVote{ProposalId: result.ProposalId, Support: result.Support}
+		if result.Error != "" {
+			outcomes = append(outcomes, batchVoteOutcome{vote: vote, result: "failed: " + result.Error})
+			continue
+		}
+		fmt.Printf("Proposal %d (nonce %d):\n", result.ProposalId, result.Nonce)
+		cliutils.PrintTransactionHashNoCancel(rp, result.TxHash)
+		if _, err = rp.WaitForTransaction(result.TxHash); err != nil {
+			outcomes = append(outcomes, batchVoteOutcome{vote: vote, result: fmt.Sprintf("failed: %s", err.Error())})
+			continue
+		}
+		outcomes = append(outcomes, batchVoteOutcome{vote: vote, result: fmt.Sprintf("voted %s (%s)", getSupportLabel(result.Support), result.TxHash.Hex())})
+	}
+
+	// Print the summary
+	fmt.Println()
+	fmt.Println("Summary:")
+	for _, vote := range votes {
+		for _, outcome := range outcomes {
+			if outcome.vote.ProposalId == vote.ProposalId {
+				fmt.Printf("\tProposal %d: %s\n", vote.ProposalId, outcome.result)
+			}
+		}
+	}
+	return nil
+
+}
+
+// Get the file an unsigned vote is saved to, which adds the proposal ID to the provided file name
+func getVoteTransactionPath(path string, proposalId uint64) string {
+	extension := filepath.Ext(path)
+	base := strings.TrimSuffix(path, extension)
+	if extension == "" {
+		extension = ".json"
+	}
+	return fmt.Sprintf("%s-%d%s", base, proposalId, extension)
+}
+
+// Get a description of a vote
+func getSupportLabel(support bool) string {
+	if support {
+		return "in support"
+	}
+	return "against"
+}
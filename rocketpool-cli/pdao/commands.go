@@ -0,0 +1,36 @@
+package pdao
+
+import (
+	"github.com/urfave/cli"
+
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage the poolsea Pool protocol DAO",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "settings",
+				Aliases:   []string{"t"},
+				Usage:     "Get the current protocol DAO settings",
+				UsageText: "poolseapool pdao settings",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getSettings(c)
+
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
+)
+
+func getSettings(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the current settings
+	response, err := rp.PDAOSettings()
+	if err != nil {
+		return err
+	}
+
+	// Print & return
+	for _, value := range response.Settings {
+		setting, err := rputils.GetPDAOSetting(value.Name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%-38s %s\n", setting.Name, setting.FormatValue(value.Value))
+	}
+	fmt.Println()
+	fmt.Println("The protocol DAO on this network is managed by its guardian; these settings can't be changed with on-chain proposals.")
+	return nil
+
+}
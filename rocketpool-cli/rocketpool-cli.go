@@ -7,12 +7,14 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/urfave/cli"
 
+	"github.com/Seb369888/smartnode/rocketpool-cli/analytics"
 	"github.com/Seb369888/smartnode/rocketpool-cli/auction"
 	"github.com/Seb369888/smartnode/rocketpool-cli/faucet"
 	"github.com/Seb369888/smartnode/rocketpool-cli/minipool"
 	"github.com/Seb369888/smartnode/rocketpool-cli/network"
 	"github.com/Seb369888/smartnode/rocketpool-cli/node"
 	"github.com/Seb369888/smartnode/rocketpool-cli/odao"
+	"github.com/Seb369888/smartnode/rocketpool-cli/pdao"
 	"github.com/Seb369888/smartnode/rocketpool-cli/queue"
 	"github.com/Seb369888/smartnode/rocketpool-cli/service"
 	"github.com/Seb369888/smartnode/rocketpool-cli/wallet"
@@ -90,6 +92,14 @@ func main() {
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.StringFlag{
+			Name:  "watch",
+			Usage: "Run read-only queries such as status commands against this node `address` instead of your own, without needing a wallet",
+		},
+		cli.BoolFlag{
+			Name:  "simulate",
+			Usage: "Simulate transactions against the pending block and show the expected result and balance changes instead of sending them",
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -98,6 +108,7 @@ func main() {
 	}
 
 	// Register commands
+	analytics.RegisterCommands(app, "analytics", []string{"y"})
 	auction.RegisterCommands(app, "auction", []string{"a"})
 
 	// Get the config path from the arguments (or use the default)
@@ -138,6 +149,7 @@ func main() {
 	network.RegisterCommands(app, "network", []string{"e"})
 	node.RegisterCommands(app, "node", []string{"n"})
 	odao.RegisterCommands(app, "odao", []string{"o"})
+	pdao.RegisterCommands(app, "pdao", []string{"pd"})
 	queue.RegisterCommands(app, "queue", []string{"q"})
 	service.RegisterCommands(app, "service", []string{"s"})
 	wallet.RegisterCommands(app, "wallet", []string{"w"})
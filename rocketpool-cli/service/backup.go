@@ -0,0 +1,204 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/backup"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Create an encrypted backup of the node's state
+func backupNode(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the config
+	settingsPath, dataPath, err := getBackupPaths(c, rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the output path
+	outputPath := c.String("output")
+	if outputPath == "" {
+		outputPath = backup.GetFilename(time.Now())
+	}
+	outputPath, err = homedir.Expand(outputPath)
+	if err != nil {
+		return fmt.Errorf("error expanding output path: %w", err)
+	}
+	if _, err := os.Stat(outputPath); err == nil {
+		if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("The file %s already exists. Would you like to overwrite it?", outputPath))) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	// Get the passphrase
+	passphrase := promptBackupPassphrase()
+
+	// Create the backup
+	fmt.Println("Creating backup...")
+	entries, err := backup.SaveBackup(outputPath, settingsPath, dataPath, passphrase)
+	if err != nil {
+		return fmt.Errorf("error saving backup to %s: %w", outputPath, err)
+	}
+
+	// Print the contents
+	fmt.Printf("Backed up %d files to %s%s%s.\n", len(entries), colorGreen, outputPath, colorReset)
+	fmt.Printf("%sNOTE: This backup contains your node wallet and its password, protected only by the backup passphrase. Please store it somewhere safe, and do not lose the passphrase - you will need it to restore the backup.%s\n", colorYellow, colorReset)
+	return nil
+
+}
+
+// Restore the node's state from an encrypted backup
+func restoreNode(c *cli.Context, backupFile string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the config
+	settingsPath, dataPath, err := getBackupPaths(c, rp)
+	if err != nil {
+		return err
+	}
+
+	// Read the backup
+	backupFile, err = homedir.Expand(backupFile)
+	if err != nil {
+		return fmt.Errorf("error expanding backup file path: %w", err)
+	}
+	backupReader, err := os.Open(backupFile)
+	if err != nil {
+		return fmt.Errorf("error reading backup file %s: %w", backupFile, err)
+	}
+	defer backupReader.Close()
+
+	// Decrypt it and show the contents
+	passphrase := cliutils.PromptPassword("Please enter the passphrase for this backup:", "^.*$", "")
+	entries, err := backup.ListBackup(backupReader, passphrase)
+	if err != nil {
+		return err
+	}
+	fmt.Println("This backup contains the following files:")
+	for _, entry := range entries {
+		fmt.Printf("\t%s\n", entry)
+	}
+	fmt.Println()
+
+	// Show a warning message
+	fmt.Printf("%sWARNING: Restoring this backup will overwrite the existing copies of these files in %s and your settings file at %s.\n", colorRed, dataPath, settingsPath)
+	fmt.Printf("If your Smartnode is running, please stop it with `rocketpool service stop` first.%s\n\n", colorReset)
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to restore this backup?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Restore it
+	if _, err := backupReader.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error reading backup file %s: %w", backupFile, err)
+	}
+	restored, err := backup.RestoreBackup(backupReader, passphrase, settingsPath, dataPath)
+	if err != nil {
+		return fmt.Errorf("error restoring backup: %w", err)
+	}
+	fmt.Printf("Restored %d files.\n\n", len(restored))
+	fmt.Println("Please start the Smartnode with `rocketpool service start` to use the restored state.")
+	fmt.Println("Your validator keys were not part of the backup; if they are missing, you can regenerate them with `rocketpool wallet rebuild`.")
+	return nil
+
+}
+
+// Set the passphrase the node daemon encrypts its automatic backups with
+func setBackupPassphrase(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the passphrase file path
+	_, dataPath, err := getBackupPaths(c, rp)
+	if err != nil {
+		return err
+	}
+	passphrasePath := filepath.Join(dataPath, config.BackupPassphraseFilename)
+	if _, err := os.Stat(passphrasePath); err == nil {
+		if !(c.Bool("yes") || cliutils.Confirm("A backup passphrase has already been set. Backups made with it will still need the old passphrase to restore. Would you like to replace it?")) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	// Save the passphrase
+	passphrase := promptBackupPassphrase()
+	err = os.WriteFile(passphrasePath, []byte(passphrase), backup.DefaultFileMode)
+	if err != nil {
+		return fmt.Errorf("error saving backup passphrase to %s: %w", passphrasePath, err)
+	}
+	fmt.Printf("The backup passphrase was saved to %s. The node daemon will use it for its next automatic backup.\n", passphrasePath)
+	fmt.Printf("%sNOTE: Please store a copy of this passphrase somewhere other than this machine - you will need it to restore any automatic backups.%s\n", colorYellow, colorReset)
+	return nil
+
+}
+
+// Get the locations of the settings file and data folder on the host
+func getBackupPaths(c *cli.Context, rp *rocketpool.Client) (string, string, error) {
+
+	// Load the config
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return "", "", fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		return "", "", fmt.Errorf("Settings file not found. Please run `rocketpool service config` to set up your Smartnode first.")
+	}
+
+	settingsPath, err := homedir.Expand(filepath.Join(c.GlobalString("config-path"), rocketpool.SettingsFile))
+	if err != nil {
+		return "", "", fmt.Errorf("error expanding settings file path: %w", err)
+	}
+	dataPath, err := homedir.Expand(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return "", "", fmt.Errorf("error expanding data path: %w", err)
+	}
+	return settingsPath, os.ExpandEnv(dataPath), nil
+
+}
+
+// Prompt for a new backup passphrase
+func promptBackupPassphrase() string {
+	for {
+		passphrase := cliutils.PromptPassword(
+			"Please enter a passphrase to encrypt the backup with:",
+			fmt.Sprintf("^.{%d,}$", backup.MinPassphraseLength),
+			fmt.Sprintf("Your passphrase must be at least %d characters long. Please try again:", backup.MinPassphraseLength),
+		)
+		confirmation := cliutils.PromptPassword("Please confirm your passphrase:", "^.*$", "")
+		if passphrase == confirmation {
+			return passphrase
+		}
+		fmt.Println("Passphrase confirmation does not match.")
+		fmt.Println("")
+	}
+}
@@ -506,6 +506,80 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "backup",
+				Usage:     "Create an encrypted backup of your node's settings, wallet, custom validator keys, fee recipient files, watchtower state and rewards tree files",
+				UsageText: "poolseapool service backup [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The file to save the backup to (defaults to a timestamped file in the current directory)",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm overwriting an existing backup file",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return backupNode(c)
+
+				},
+			},
+
+			{
+				Name:      "set-backup-passphrase",
+				Usage:     "Set the passphrase the node daemon encrypts its automatic backups with",
+				UsageText: "poolseapool service set-backup-passphrase [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm replacing an existing backup passphrase",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return setBackupPassphrase(c)
+
+				},
+			},
+
+			{
+				Name:      "restore",
+				Usage:     "Restore your node's state from an encrypted backup created by `service backup` or by the node daemon",
+				UsageText: "poolseapool service restore backup-file [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm overwriting the existing files",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					backupFile := c.Args().Get(0)
+
+					// Run command
+					return restoreNode(c, backupFile)
+
+				},
+			},
+
 			{
 				Name:      "terminate",
 				Aliases:   []string{"t"},
@@ -0,0 +1,154 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+func auditKeys(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Audit the keys
+	fmt.Println("Auditing validator keys, this may take a moment...")
+	response, err := rp.AuditValidatorKeys()
+	if err != nil {
+		return err
+	}
+	if len(response.Keys) == 0 {
+		fmt.Println("The node does not have any minipools with a validator key.")
+		return nil
+	}
+
+	// Print the report
+	fmt.Printf("Your Validator Client uses the %s keystore.\n\n", response.ValidatorClient)
+	if len(response.SkippedCustomKeyFiles) > 0 {
+		fmt.Printf("%sNOTE: The following files in your custom key folder are not validator keystores and were skipped:\n", colorYellow)
+		for _, file := range response.SkippedCustomKeyFiles {
+			fmt.Printf("\t%s\n", file)
+		}
+		fmt.Printf("%s\n", colorReset)
+	}
+	issues := []api.ValidatorKeyAudit{}
+	for _, key := range response.Keys {
+		fmt.Printf("Minipool %s:\n", key.MinipoolAddress.Hex())
+		fmt.Printf("\tValidator pubkey: %s\n", key.Pubkey.Hex())
+		if key.IsDerived {
+			fmt.Printf("\tOrigin:           node wallet, index %d (path %s)\n", key.WalletIndex, key.DerivationPath)
+		} else if key.IsCustomKey {
+			fmt.Println("\tOrigin:           custom key")
+		} else {
+			fmt.Printf("\tOrigin:           %sunknown (not derived from the node wallet and not a custom key)%s\n", colorYellow, colorReset)
+		}
+		if key.Exited {
+			fmt.Println("\tStatus:           exited")
+		} else {
+			fmt.Println("\tStatus:           active")
+		}
+		if len(key.Keystores) == 0 {
+			fmt.Println("\tKeystores:        none")
+		} else {
+			fmt.Printf("\tKeystores:        %s\n", strings.Join(key.Keystores, ", "))
+		}
+		if key.Issue != api.ValidatorKeyIssue_None {
+			fmt.Printf("\t%sIssue:            %s%s\n", colorRed, getKeyIssueDescription(key.Issue), colorReset)
+			issues = append(issues, key)
+		}
+		fmt.Println()
+	}
+
+	if len(issues) == 0 {
+		fmt.Printf("%sAll of your validator keys are where they should be.%s\n", colorGreen, colorReset)
+		return nil
+	}
+	fmt.Printf("Found %d validator key issue(s).\n\n", len(issues))
+
+	// Offer to repair each issue
+	repaired := 0
+	for _, key := range issues {
+		switch key.Issue {
+		case api.ValidatorKeyIssue_LoadedButExited:
+			if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Minipool %s has exited, but its validator key is still loaded. Would you like to remove it from your keystores?", key.MinipoolAddress.Hex()))) {
+				continue
+			}
+
+		case api.ValidatorKeyIssue_MissingLocally, api.ValidatorKeyIssue_NotInValidatorClient:
+			if !key.IsDerived {
+				if key.IsCustomKey {
+					fmt.Printf("The validator key for minipool %s is a custom key; please restore it with `rocketpool wallet rebuild`.\n\n", key.MinipoolAddress.Hex())
+				} else {
+					fmt.Printf("The validator key for minipool %s could not be derived from your node wallet, so it cannot be repaired automatically.\n\n", key.MinipoolAddress.Hex())
+				}
+				continue
+			}
+			// Restoring a key can get it slashed, so `--yes` doesn't confirm it
+			fmt.Printf("%sWARNING: Only restore this key if it is NOT running on any other machine. Running the same key in two places WILL RESULT IN YOUR VALIDATOR BEING SLASHED.%s\n", colorRed, colorReset)
+			if !(c.Bool("confirm-key-restore") || cliutils.Confirm(fmt.Sprintf("Would you like to restore the validator key for minipool %s from your node wallet?", key.MinipoolAddress.Hex()))) {
+				continue
+			}
+		}
+
+		if _, err := rp.RepairValidatorKey(key.MinipoolAddress); err != nil {
+			fmt.Printf("%sCould not repair the validator key for minipool %s: %s%s\n\n", colorRed, key.MinipoolAddress.Hex(), err.Error(), colorReset)
+			continue
+		}
+		fmt.Printf("Repaired the validator key for minipool %s.\n\n", key.MinipoolAddress.Hex())
+		repaired++
+	}
+
+	// Restart the VC so it picks up the changes
+	if repaired == 0 {
+		return nil
+	}
+	if c.Bool("yes") || cliutils.Confirm("Would you like to restart the Smartnode's Validator Client now so it loads your repaired keys?") {
+		fmt.Print("Restarting Validator Client... ")
+		if _, err := rp.RestartVc(); err != nil {
+			fmt.Printf("failed!\n%sWARNING: error restarting validator client: %s\n\nPlease restart it manually so it picks up the repaired validator keys.%s\n", colorYellow, err.Error(), colorReset)
+			return nil
+		}
+		fmt.Println("done!")
+	}
+	return nil
+
+}
+
+// Get a description of a validator key issue
+func getKeyIssueDescription(issue api.ValidatorKeyIssue) string {
+	switch issue {
+	case api.ValidatorKeyIssue_MissingLocally:
+		return "the key is missing from all of your keystores"
+	case api.ValidatorKeyIssue_NotInValidatorClient:
+		return "the key is not in your Validator Client's keystore"
+	case api.ValidatorKeyIssue_LoadedButExited:
+		return "the minipool has exited but its key is still loaded"
+	default:
+		return string(issue)
+	}
+}
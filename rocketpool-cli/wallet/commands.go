@@ -130,6 +130,37 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Provide the password for an existing node wallet, storing it in the password storage selected in the Smartnode settings",
+				UsageText: "poolseapool wallet unlock [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password, p",
+						Usage: "The password of the node wallet",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("password") != "" {
+						if _, err := cliutils.ValidateNodePassword("password", c.String("password")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return unlockWallet(c)
+
+				},
+			},
+
 			{
 				Name:      "rebuild",
 				Aliases:   []string{"b"},
@@ -148,6 +179,94 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "audit-keys",
+				Aliases:   []string{"a"},
+				Usage:     "Check where each of your minipools' validator keys came from and whether they are loaded correctly, and offer to repair any problems",
+				UsageText: "poolseapool wallet audit-keys [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm all repairs except restoring validator keys",
+					},
+					cli.BoolFlag{
+						Name:  "confirm-key-restore",
+						Usage: "Restore missing validator keys without asking. Only use this if you are sure none of the keys are running on any other machine; running the same key in two places WILL RESULT IN YOUR VALIDATOR BEING SLASHED.",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return auditKeys(c)
+
+				},
+			},
+
+			{
+				Name:      "sign-offline",
+				Usage:     "Sign a transaction exported by the node (e.g. with `node stake-rpl --offline`) using your mnemonic. This is meant to be run on an offline machine and does not need the Smartnode to be running.",
+				UsageText: "poolseapool wallet sign-offline [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "The unsigned transaction file exported by the node",
+					},
+					cli.StringFlag{
+						Name:  "blob, b",
+						Usage: "The unsigned transaction payload exported by the node, if you don't have the file",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The file to save the signed transaction to",
+						Value: "signed-tx.json",
+					},
+					cli.StringFlag{
+						Name:  "mnemonic, m",
+						Usage: "The mnemonic phrase of the node wallet",
+					},
+					cli.StringFlag{
+						Name:  "derivation-path, d",
+						Usage: "Specify the derivation path for the wallet.\nOmit this flag (or leave it blank) for the default of \"m/44'/60'/0'/0/%d\" (where %d is the index).\nSet this to \"ledgerLive\" to use Ledger Live's path of \"m/44'/60'/%d/0/0\".\nSet this to \"mew\" to use MyEtherWallet's path of \"m/44'/60'/0'/%d\".\nFor custom paths, simply enter them here.",
+					},
+					cli.UintFlag{
+						Name:  "wallet-index, i",
+						Usage: "Specify the index to use with the derivation path",
+						Value: 0,
+					},
+					cli.Uint64Flag{
+						Name:  "chain-id",
+						Usage: "The chain ID of the network the transaction is for, if the Smartnode isn't configured on this machine",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("mnemonic") != "" {
+						if _, err := cliutils.ValidateWalletMnemonic("mnemonic", c.String("mnemonic")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return signOffline(c)
+
+				},
+			},
+
 			{
 				Name:      "test-recovery",
 				Aliases:   []string{"t"},
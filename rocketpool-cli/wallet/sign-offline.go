@@ -0,0 +1,236 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mitchellh/go-homedir"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	"github.com/Seb369888/smartnode/shared/services/wallet/derivation"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+	hexutils "github.com/Seb369888/smartnode/shared/utils/hex"
+)
+
+// Sign a transaction that was exported by the node, without using the node or the Smartnode daemon.
+// This is meant to be run on an offline (air-gapped) machine.
+func signOffline(c *cli.Context) error {
+
+	// Load the unsigned transaction
+	var offlineTx api.OfflineTransaction
+	if c.String("file") != "" {
+		path, err := homedir.Expand(c.String("file"))
+		if err != nil {
+			return fmt.Errorf("error expanding transaction file path: %w", err)
+		}
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading transaction file: %w", err)
+		}
+		if err := json.Unmarshal(bytes, &offlineTx); err != nil {
+			return fmt.Errorf("error parsing transaction file: %w", err)
+		}
+	} else {
+		payload := c.String("blob")
+		if payload == "" {
+			payload = cliutils.Prompt("Please enter the unsigned transaction payload:", "^(0x)?[0-9a-fA-F]+$", "Invalid payload")
+		}
+		bytes, err := hexutil.Decode(hexutils.AddPrefix(strings.TrimSpace(payload)))
+		if err != nil {
+			return fmt.Errorf("error decoding transaction payload: %w", err)
+		}
+		offlineTx.Payload = bytes
+	}
+
+	// Decode the payload; this is what actually gets signed, so it's what gets shown for review
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(offlineTx.Payload); err != nil {
+		return fmt.Errorf("error decoding unsigned transaction: %w", err)
+	}
+	if _, r, s := tx.RawSignatureValues(); r.Sign() != 0 || s.Sign() != 0 {
+		return fmt.Errorf("this transaction has already been signed")
+	}
+	if tx.To() == nil {
+		return fmt.Errorf("this transaction does not have a recipient")
+	}
+
+	// Make sure the transaction is for the right network, so it can't be replayed elsewhere
+	expectedChainID, err := getOfflineChainID(c)
+	if err != nil {
+		return err
+	}
+	if tx.ChainId().Sign() == 0 {
+		return fmt.Errorf("this transaction does not have a chain ID; please export it again with an updated Smartnode")
+	}
+	if tx.ChainId().Cmp(expectedChainID) != 0 {
+		return fmt.Errorf("this transaction is for chain ID %s, but your network's chain ID is %s", tx.ChainId().String(), expectedChainID.String())
+	}
+	if offlineTx.ChainID != nil && offlineTx.ChainID.ToInt().Cmp(tx.ChainId()) != 0 {
+		return fmt.Errorf("the transaction file says it's for chain ID %s, but its payload is for chain ID %s", offlineTx.ChainID.ToInt().String(), tx.ChainId().String())
+	}
+
+	// Print the transaction details
+	fmt.Println("Transaction details:")
+	if offlineTx.Description != "" {
+		fmt.Printf("\tDescription:      %s\n", offlineTx.Description)
+	}
+	fmt.Printf("\tChain ID:         %s\n", tx.ChainId().String())
+	if offlineTx.From != (common.Address{}) {
+		fmt.Printf("\tFrom:             %s\n", offlineTx.From.Hex())
+	}
+	fmt.Printf("\tTo:               %s\n", tx.To().Hex())
+	fmt.Printf("\tNonce:            %d\n", tx.Nonce())
+	fmt.Printf("\tValue:            %.6f ETH\n", eth.WeiToEth(tx.Value()))
+	fmt.Printf("\tGas limit:        %d\n", tx.Gas())
+	fmt.Printf("\tMax fee:          %.6f gwei\n", eth.WeiToGwei(tx.GasFeeCap()))
+	fmt.Printf("\tMax priority fee: %.6f gwei\n", eth.WeiToGwei(tx.GasTipCap()))
+	fmt.Printf("\tData:             %s\n\n", hexutil.Encode(tx.Data()))
+
+	// Get the mnemonic
+	var mnemonic string
+	if c.String("mnemonic") != "" {
+		mnemonic = c.String("mnemonic")
+	} else {
+		mnemonic = PromptMnemonic()
+	}
+	mnemonic = strings.TrimSpace(mnemonic)
+
+	// Get the derivation path
+	path := c.String("derivation-path")
+	switch path {
+	case "":
+		path = derivation.DefaultNodeKeyPath
+	case "ledgerLive":
+		path = derivation.LedgerLiveNodeKeyPath
+	case "mew":
+		path = derivation.MyEtherWalletNodeKeyPath
+	}
+
+	// Derive the node key
+	privateKey, err := deriveNodeKey(mnemonic, path, c.Uint("wallet-index"), tx.ChainId())
+	if err != nil {
+		return err
+	}
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+	if offlineTx.From != (common.Address{}) && offlineTx.From != address {
+		return fmt.Errorf("this mnemonic belongs to %s, but the transaction must be sent from %s; please check your mnemonic, derivation path and wallet index", address.Hex(), offlineTx.From.Hex())
+	}
+	fmt.Printf("Signing with node account %s.\n\n", address.Hex())
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to sign this transaction?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign the transaction
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(tx.ChainId()), privateKey)
+	if err != nil {
+		return fmt.Errorf("error signing transaction: %w", err)
+	}
+	payload, err := signedTx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("error serializing signed transaction: %w", err)
+	}
+	signedOfflineTx := api.SignedOfflineTransaction{
+		Description: offlineTx.Description,
+		From:        address,
+		Nonce:       hexutil.Uint64(signedTx.Nonce()),
+		TxHash:      signedTx.Hash(),
+		Payload:     payload,
+	}
+
+	// Save it
+	outputPath := c.String("output")
+	if outputPath == "" {
+		outputPath = "signed-tx.json"
+	}
+	outputPath, err = homedir.Expand(outputPath)
+	if err != nil {
+		return fmt.Errorf("error expanding output path: %w", err)
+	}
+	bytes, err := json.MarshalIndent(signedOfflineTx, "", "    ")
+	if err != nil {
+		return fmt.Errorf("error serializing signed transaction file: %w", err)
+	}
+	if err := os.WriteFile(outputPath, bytes, 0600); err != nil {
+		return fmt.Errorf("error saving signed transaction file: %w", err)
+	}
+
+	// Log & return
+	fmt.Printf("%sThe transaction was signed and saved to %s.%s\n", colorGreen, outputPath, colorReset)
+	fmt.Printf("Transaction hash: %s\n\n", signedTx.Hash().Hex())
+	fmt.Println("The signed transaction payload is:")
+	fmt.Println(hexutil.Encode(payload))
+	fmt.Println()
+	fmt.Println("Copy the file (or payload) to your node and submit it with `rocketpool node broadcast`.")
+	return nil
+
+}
+
+// Get the chain ID transactions must be signed for, from the flag or the Smartnode settings
+func getOfflineChainID(c *cli.Context) (*big.Int, error) {
+
+	if c.Uint64("chain-id") != 0 {
+		return new(big.Int).SetUint64(c.Uint64("chain-id")), nil
+	}
+
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return nil, err
+	}
+	defer rp.Close()
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		return nil, fmt.Errorf("Settings file not found. Please specify the chain ID of your network with the `--chain-id` flag.")
+	}
+	return new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID())), nil
+
+}
+
+// Derive the node's private key from a mnemonic
+func deriveNodeKey(mnemonic string, path string, index uint, chainID *big.Int) (*ecdsa.PrivateKey, error) {
+
+	// Check the mnemonic
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid mnemonic")
+	}
+
+	// Create the master key
+	seed := bip39.NewSeed(mnemonic, "")
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, fmt.Errorf("error creating master key: %w", err)
+	}
+
+	// Follow the derivation path the same way the node wallet does
+	key, _, err = derivation.DeriveNodeKey(key, path, index, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the private key
+	privateKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting node private key: %w", err)
+	}
+	return privateKey.ToECDSA(), nil
+
+}
@@ -31,7 +31,10 @@ func getStatus(c *cli.Context) error {
 	}
 
 	// Print status & return
-	if status.WalletInitialized {
+	if status.WatchOnly {
+		fmt.Println("Watching another node in read-only mode; the local node wallet is not loaded.")
+		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
+	} else if status.WalletInitialized {
 		fmt.Println("The node wallet is initialized.")
 		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
 	} else {
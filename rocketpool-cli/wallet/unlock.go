@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services/passwords"
+	"github.com/Seb369888/smartnode/shared/services/rocketpool"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+func unlockWallet(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if status.PasswordSet {
+		fmt.Println("The node wallet password is already available to the Smartnode.")
+		return nil
+	}
+
+	// Get the password
+	password := c.String("password")
+	if password == "" {
+		password = cliutils.PromptPassword(
+			"Please enter the password for your node wallet:",
+			fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+		)
+	}
+
+	// Unlock the wallet
+	if _, err := rp.UnlockWallet(password); err != nil {
+		return err
+	}
+	fmt.Println("The node wallet has been unlocked.")
+	return nil
+
+}
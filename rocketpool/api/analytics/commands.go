@@ -0,0 +1,46 @@
+package analytics
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/utils/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Register subcommands
+func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Query the node's historical analytics",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "snapshots",
+				Aliases:   []string{"s"},
+				Usage:     "Get the analytics snapshots from the last number of days, keeping at most one per interval (in minutes, 0 for all of them)",
+				UsageText: "poolsea api analytics snapshots days interval",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					days, err := cliutils.ValidatePositiveUint("days", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					interval, err := cliutils.ValidateUint("interval", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSnapshots(c, days, interval))
+					return nil
+
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package analytics
+
+import (
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/analytics"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+func getSnapshots(c *cli.Context, days uint64, intervalMinutes uint64) (*api.AnalyticsSnapshotsResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.AnalyticsSnapshotsResponse{}
+
+	// Open the store
+	store, err := analytics.Open(os.ExpandEnv(cfg.Smartnode.GetAnalyticsPath(true)), true)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	// Get the snapshots
+	since := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	response.Snapshots, err = store.GetSnapshots(since, time.Duration(intervalMinutes)*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	response.Minipools = analytics.GetMinipoolPerformance(response.Snapshots)
+	response.Rewards, err = store.GetRewards(since)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
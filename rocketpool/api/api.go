@@ -6,16 +6,19 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/poolsea-go/utils"
+	"github.com/Seb369888/smartnode/rocketpool/api/analytics"
 	"github.com/Seb369888/smartnode/rocketpool/api/auction"
 	"github.com/Seb369888/smartnode/rocketpool/api/faucet"
 	"github.com/Seb369888/smartnode/rocketpool/api/minipool"
 	"github.com/Seb369888/smartnode/rocketpool/api/network"
 	"github.com/Seb369888/smartnode/rocketpool/api/node"
 	"github.com/Seb369888/smartnode/rocketpool/api/odao"
+	"github.com/Seb369888/smartnode/rocketpool/api/pdao"
 	"github.com/Seb369888/smartnode/rocketpool/api/queue"
 	apiservice "github.com/Seb369888/smartnode/rocketpool/api/service"
 	"github.com/Seb369888/smartnode/rocketpool/api/wallet"
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/simulation"
 	apitypes "github.com/Seb369888/smartnode/shared/types/api"
 	"github.com/Seb369888/smartnode/shared/utils/api"
 	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
@@ -57,13 +60,21 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 		return err
 	}
 
+	// Simulate the transactions estimated by the can-* commands so the CLI can show the results before they're confirmed
+	command.Before = func(context *cli.Context) error {
+		simulation.RecordEstimates()
+		return nil
+	}
+
 	// Register subcommands
+	analytics.RegisterSubcommands(&command, "analytics", []string{"y"})
 	auction.RegisterSubcommands(&command, "auction", []string{"a"})
 	faucet.RegisterSubcommands(&command, "faucet", []string{"f"})
 	minipool.RegisterSubcommands(&command, "minipool", []string{"m"})
 	network.RegisterSubcommands(&command, "network", []string{"e"})
 	node.RegisterSubcommands(&command, "node", []string{"n"})
 	odao.RegisterSubcommands(&command, "odao", []string{"o"})
+	pdao.RegisterSubcommands(&command, "pdao", []string{"pd"})
 	queue.RegisterSubcommands(&command, "queue", []string{"q"})
 	wallet.RegisterSubcommands(&command, "wallet", []string{"w"})
 	apiservice.RegisterSubcommands(&command, "service", []string{"s"})
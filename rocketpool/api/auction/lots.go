@@ -35,7 +35,7 @@ func getLots(c *cli.Context) (*api.AuctionLotsResponse, error) {
 	}
 
 	// Get lot details
-	lots, err := getAllLotDetails(rp, nodeAccount.Address)
+	lots, err := GetAllLotDetails(rp, nodeAccount.Address)
 	if err != nil {
 		return nil, err
 	}
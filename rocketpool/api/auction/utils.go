@@ -200,7 +200,7 @@ func getLotCountDetails(rp *rocketpool.RocketPool, bidderAddress common.Address,
 }
 
 // Get all lot details
-func getAllLotDetails(rp *rocketpool.RocketPool, bidderAddress common.Address) ([]api.LotDetails, error) {
+func GetAllLotDetails(rp *rocketpool.RocketPool, bidderAddress common.Address) ([]api.LotDetails, error) {
 
 	// Get lot count
 	lotCount, err := auction.GetLotCount(rp, nil)
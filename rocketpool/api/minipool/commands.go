@@ -34,6 +34,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "events",
+				Usage:     "Get the lifecycle transitions of the node's minipools recorded by the node daemon",
+				UsageText: "poolsea api minipool events",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getEvents(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-stake",
 				Usage:     "Check whether the minipool is ready to be staked, moving from prelaunch to staking status",
@@ -252,6 +270,62 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-signed-exit-message",
+				Usage:     "Get a pre-signed voluntary exit message for a minipool's validator, which can be broadcast later",
+				UsageText: "poolsea api minipool get-signed-exit-message minipool-address",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "epoch, e",
+						Usage: "The epoch to sign the exit message for (defaults to the current epoch)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSignedExitMessage(c, minipoolAddress, c.Uint64("epoch")))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast-exit-message",
+				Usage:     "Broadcast a pre-signed voluntary exit message to the Beacon Chain",
+				UsageText: "poolsea api minipool broadcast-exit-message validator-index epoch signature",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					validatorIndex, err := cliutils.ValidateUint("validator index", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					epoch, err := cliutils.ValidateUint("epoch", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					signature, err := cliutils.ValidateSignature("signature", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(broadcastExitMessage(c, validatorIndex, epoch, signature))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "get-minipool-close-details-for-node",
@@ -0,0 +1,31 @@
+package minipool
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+func getEvents(c *cli.Context) (*api.MinipoolEventsResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolEventsResponse{}
+
+	// Read the event log written by the node daemon
+	response.Transitions, err = lifecycle.ReadTransitions(cfg.Smartnode.GetMinipoolEventLogPath())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -1,6 +1,8 @@
 package minipool
 
 import (
+	"fmt"
+
 	"github.com/Seb369888/poolsea-go/minipool"
 	"github.com/Seb369888/poolsea-go/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -128,3 +130,120 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	return &response, nil
 
 }
+
+func getSignedExitMessage(c *cli.Context, minipoolAddress common.Address, epoch uint64) (*api.GetSignedExitMessageResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetSignedExitMessageResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate minipool owner
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get minipool validator pubkey
+	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.ValidatorPubkey = validatorPubkey
+
+	// Get validator private key
+	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to the current epoch
+	if epoch == 0 {
+		head, err := bc.GetBeaconHead()
+		if err != nil {
+			return nil, err
+		}
+		epoch = head.Epoch
+	}
+	response.Epoch = epoch
+
+	// Get the voluntary exit signature domain; per EIP-7044, exits are always signed with the Capella fork version
+	// so they remain valid across future forks
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	if len(eth2Config.CapellaForkVersion) == 0 {
+		return nil, fmt.Errorf("Your Beacon Node did not provide the Capella fork version, so EIP-7044 exit messages cannot be signed.")
+	}
+	signatureDomain := eth2types.Domain(eth2types.DomainVoluntaryExit, eth2Config.CapellaForkVersion, eth2Config.GenesisValidatorsRoot)
+
+	// Get validator index
+	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+	response.ValidatorIndex = validatorIndex
+
+	// Get signed voluntary exit message
+	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return nil, err
+	}
+	response.Signature = signature
+
+	// Return response
+	return &response, nil
+
+}
+
+func broadcastExitMessage(c *cli.Context, validatorIndex uint64, epoch uint64, signature types.ValidatorSignature) (*api.BroadcastExitMessageResponse, error) {
+
+	// Get services
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastExitMessageResponse{}
+
+	// Broadcast voluntary exit message
+	if err := bc.ExitValidator(validatorIndex, epoch, signature); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
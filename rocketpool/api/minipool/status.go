@@ -2,12 +2,21 @@ package minipool
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	rpstate "github.com/Seb369888/poolsea-go/utils/state"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/types/api"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
 )
 
 func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
@@ -59,6 +68,17 @@ func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
 	}
 	response.Minipools = details
 
+	// Estimate when the queued minipools will be assigned
+	if response.IsAtlasDeployed {
+		// The estimates and stages are only informational, so the status is still shown without them
+		if err := estimateQueueWaitTimes(rp, bc, cfg, &response); err != nil {
+			response.QueueEstimateError = err.Error()
+		}
+		if err := getLifecycleStages(c, rp, bc, cfg, nodeAccount.Address, &response); err != nil {
+			response.LifecycleStageError = err.Error()
+		}
+	}
+
 	delegate, err := rp.GetContract("poolseaMinipoolDelegate", nil)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting latest minipool delegate contract: %w", err)
@@ -70,3 +90,90 @@ func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
 	return &response, nil
 
 }
+
+// Estimate how long each of the node's queued minipools will wait before being assigned
+func estimateQueueWaitTimes(rp *rocketpool.RocketPool, bc beacon.Client, cfg *config.RocketPoolConfig, response *api.MinipoolStatusResponse) error {
+
+	// Check if any minipools are in the queue
+	queued := false
+	for _, mp := range response.Minipools {
+		if mp.Queue.Position > 0 {
+			queued = true
+			break
+		}
+	}
+	if !queued {
+		return nil
+	}
+
+	// Get the network details
+	multicallerAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
+	balanceBatcherAddress := common.HexToAddress(cfg.Smartnode.GetBalanceBatcherAddress())
+	contracts, err := rpstate.NewNetworkContracts(rp, multicallerAddress, balanceBatcherAddress, true, nil)
+	if err != nil {
+		return fmt.Errorf("error getting network contracts: %w", err)
+	}
+	networkDetails, err := rpstate.NewNetworkDetails(rp, contracts, true)
+	if err != nil {
+		return fmt.Errorf("error getting network details: %w", err)
+	}
+
+	// Get the deposit pool inflow rate
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return err
+	}
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return err
+	}
+	lookback := time.Duration(cfg.Smartnode.QueueEtaLookback.Value.(uint64)) * 24 * time.Hour
+	inflowRate, err := rputils.GetDepositPoolInflowRate(rp, lookback, eth2Config.SecondsPerSlot, big.NewInt(int64(eventLogInterval)))
+	if err != nil {
+		return fmt.Errorf("error getting deposit pool inflow rate: %w", err)
+	}
+	response.DepositPoolInflowRate = inflowRate
+	response.QueueEtaLookback = lookback
+
+	// Estimate the wait times
+	for i, mp := range response.Minipools {
+		if mp.Queue.Position == 0 {
+			continue
+		}
+		response.Minipools[i].QueueEstimate = rputils.EstimateQueueWaitTime(mp.Queue.Position, networkDetails.QueueLength, networkDetails.QueueCapacity.Total, networkDetails.DepositPoolBalance, inflowRate)
+	}
+	return nil
+
+}
+
+// Get the lifecycle stage of each of the node's minipools
+func getLifecycleStages(c *cli.Context, rp *rocketpool.RocketPool, bc beacon.Client, cfg *config.RocketPoolConfig, nodeAddress common.Address, response *api.MinipoolStatusResponse) error {
+
+	// Get the network state for the node
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	m, err := state.NewNetworkStateManager(rp, cfg, ec, bc, nil)
+	if err != nil {
+		return fmt.Errorf("error creating network state manager: %w", err)
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAddress, false)
+	if err != nil {
+		return fmt.Errorf("error getting network state: %w", err)
+	}
+
+	// Match the stages to the minipools
+	stages := map[common.Address]lifecycle.MinipoolStage{}
+	for _, mps := range lifecycle.GetNodeStages(networkState, nodeAddress) {
+		stages[mps.Address] = mps
+	}
+	for i, mp := range response.Minipools {
+		if mps, exists := stages[mp.Address]; exists {
+			response.Minipools[i].Stage = mps.Stage
+			response.Minipools[i].StageWaitUntil = mps.WaitUntil
+		}
+	}
+	return nil
+
+}
@@ -53,6 +53,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "gas-suggestions",
+				Aliases:   []string{"g"},
+				Usage:     "Get max fee suggestions from the fee history of the Execution client",
+				UsageText: "poolsea api network gas-suggestions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getGasSuggestions(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "stats",
 				Aliases:   []string{"s"},
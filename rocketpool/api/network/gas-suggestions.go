@@ -0,0 +1,39 @@
+package network
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+func getGasSuggestions(c *cli.Context) (*api.GasSuggestionsResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GasSuggestionsResponse{}
+
+	// Get the suggestions
+	suggestion, err := gas.GetFeeHistoryGasPrices(cfg, ec)
+	if err != nil {
+		return nil, err
+	}
+	response.Suggestion = suggestion
+
+	// Return response
+	return &response, nil
+
+}
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -150,6 +152,53 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "build-set-withdrawal-address",
+				Usage:     "Build an unsigned transaction that sets the node's withdrawal address, to be signed offline",
+				UsageText: "poolsea api node build-set-withdrawal-address node-address address confirm",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					withdrawalAddress, err := cliutils.ValidateAddress("withdrawal address", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					confirm, err := cliutils.ValidateBool("confirm", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildSetWithdrawalAddressTransaction(c, nodeAddress, withdrawalAddress, confirm))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast",
+				Usage:     "Submit a transaction that was signed offline",
+				UsageText: "poolsea api node broadcast signed-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(broadcastTransaction(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "can-confirm-withdrawal-address",
@@ -490,6 +539,57 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "build-stake-rpl-approve",
+				Usage:     "Build an unsigned RPL approval transaction for staking, to be signed offline",
+				UsageText: "poolsea api node build-stake-rpl-approve node-address amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("approve amount", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildApproveRplTransaction(c, nodeAddress, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "build-stake-rpl",
+				Usage:     "Build an unsigned RPL stake transaction, to be signed offline",
+				UsageText: "poolsea api node build-stake-rpl node-address amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("stake amount", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildStakeRplTransaction(c, nodeAddress, amountWei))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-set-stake-rpl-for-allowed",
 				Usage:     "Check whether the node can set allowed status for an address to stake RPL on behalf of themself",
@@ -1412,6 +1512,36 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "export-ledger",
+				Usage:     "Reconstruct the node's income and costs between two unix times from on-chain events and beacon withdrawals",
+				UsageText: "poolsea api node export-ledger from-time to-time include-withdrawals",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					from, err := cliutils.ValidateUint("from-time", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					to, err := cliutils.ValidateUint("to-time", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					includeWithdrawals, err := cliutils.ValidateBool("include-withdrawals", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(exportLedger(c, time.Unix(int64(from), 0), time.Unix(int64(to), 0), includeWithdrawals))
+					return nil
+
+				},
+			},
 		},
 	})
 }
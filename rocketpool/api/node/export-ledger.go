@@ -0,0 +1,631 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/minipool"
+	"github.com/Seb369888/poolsea-go/network"
+	"github.com/Seb369888/poolsea-go/node"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	"github.com/Seb369888/smartnode/shared/utils/eth1"
+)
+
+// Contracts and events used to reconstruct the ledger
+const (
+	merkleDistributorContractName   = "poolseaMerkleDistributorMainnet"
+	nodeDistributorContractName     = "poolseaNodeDistributorDelegate"
+	minipoolDelegateContractName    = "poolseaMinipoolDelegate"
+	networkPricesContractName       = "poolseaNetworkPrices"
+	rewardsClaimedEventName         = "RewardsClaimed"
+	feesDistributedEventName        = "FeesDistributed"
+	etherWithdrawalProcessedName    = "EtherWithdrawalProcessed"
+	pricesUpdatedEventName          = "PricesUpdated"
+	claimAndStakeMethodName         = "claimAndStake"
+	ledgerPriceLookback             = 7 * 24 * time.Hour
+	ledgerWithdrawalScanConcurrency = 64
+	ledgerMaxWithdrawalScanRange    = 31 * 24 * time.Hour
+)
+
+// An execution client that can retrieve full blocks
+type ledgerBlockClient interface {
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// A transaction the node sent, and the block it was included in
+type ledgerSentTx struct {
+	tx    *types.Transaction
+	block uint64
+}
+
+// Ledger entry types
+const (
+	ledgerClaimRewards         = "claim-rewards"
+	ledgerClaimAndStakeRewards = "claim-and-stake-rewards"
+	ledgerFeeDistribution      = "fee-distribution"
+	ledgerMinipoolDistribution = "minipool-distribution"
+	ledgerBeaconWithdrawal     = "beacon-withdrawal"
+	ledgerGas                  = "gas"
+)
+
+func exportLedger(c *cli.Context, from time.Time, to time.Time, includeWithdrawals bool) (*api.NodeExportLedgerResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if includeWithdrawals {
+		if err := services.RequireBeaconClientSynced(c); err != nil {
+			return nil, err
+		}
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeExportLedgerResponse{
+		Withdrawals: includeWithdrawals,
+		Entries:     []api.NodeLedgerEntry{},
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("the end of the range (%s) must be after its start (%s)", to, from)
+	}
+	if includeWithdrawals && to.Sub(from) > ledgerMaxWithdrawalScanRange {
+		return nil, fmt.Errorf("withdrawals can only be included for ranges of up to %.0f days, since every beacon block in the range has to be scanned; export longer ranges without withdrawals or in several parts", ledgerMaxWithdrawalScanRange.Hours()/24)
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the event log interval
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, err
+	}
+	intervalSize := big.NewInt(int64(eventLogInterval))
+
+	// Get the block range
+	headers := map[uint64]*types.Header{}
+	fromBlock, err := getFirstBlockAfter(rp, from, headers)
+	if err != nil {
+		return nil, err
+	}
+	toBlock, err := getFirstBlockAfter(rp, to, headers)
+	if err != nil {
+		return nil, err
+	}
+	if toBlock > 0 {
+		toBlock--
+	}
+	if toBlock < fromBlock {
+		return &response, nil
+	}
+	response.FromBlock = fromBlock
+	response.ToBlock = toBlock
+	fromBlockBig := new(big.Int).SetUint64(fromBlock)
+	toBlockBig := new(big.Int).SetUint64(toBlock)
+
+	// Historical nonces and prices need the state of old blocks, which may require the archive EC
+	archiveRp, err := eth1.GetBestApiClient(rp, cfg, func(string) {}, fromBlockBig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's contracts
+	distributorAddress, err := node.GetDistributorAddress(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee distributor address: %w", err)
+	}
+	minipoolAddresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool addresses: %w", err)
+	}
+
+	// Get the reward claims
+	merkleDistributor, err := rp.GetContract(merkleDistributorContractName, nil)
+	if err != nil {
+		return nil, err
+	}
+	claimEvent, err := getLedgerEvent(merkleDistributor.ABI, merkleDistributorContractName, rewardsClaimedEventName)
+	if err != nil {
+		return nil, err
+	}
+	claimLogs, err := eth.FilterContractLogs(rp, merkleDistributorContractName, eth.FilterQuery{
+		FromBlock: fromBlockBig,
+		ToBlock:   toBlockBig,
+		Topics:    [][]common.Hash{{claimEvent.ID}, {common.BytesToHash(nodeAccount.Address.Bytes())}},
+	}, intervalSize, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting reward claim events: %w", err)
+	}
+	for _, log := range claimLogs {
+		values := map[string]interface{}{}
+		if err := merkleDistributor.ABI.UnpackIntoMap(values, rewardsClaimedEventName, log.Data); err != nil {
+			return nil, fmt.Errorf("error decoding reward claim event in transaction %s: %w", log.TxHash.Hex(), err)
+		}
+		entryType := ledgerClaimRewards
+		tx, _, err := rp.Client.TransactionByHash(context.Background(), log.TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("error getting reward claim transaction %s: %w", log.TxHash.Hex(), err)
+		}
+		if len(tx.Data()) >= 4 {
+			if method, err := merkleDistributor.ABI.MethodById(tx.Data()[:4]); err == nil && method.Name == claimAndStakeMethodName {
+				entryType = ledgerClaimAndStakeRewards
+			}
+		}
+		response.Entries = append(response.Entries, api.NodeLedgerEntry{
+			Block:     log.BlockNumber,
+			Type:      entryType,
+			Source:    log.Address,
+			TxHash:    log.TxHash,
+			EthAmount: sumEventValues(values, "amountETH", "_amountETH"),
+			RplAmount: sumEventValues(values, "amountRPL", "_amountRPL"),
+		})
+	}
+
+	// Get the fee distributor distributions
+	distributorAbi, err := rp.GetABI(nodeDistributorContractName, nil)
+	if err != nil {
+		return nil, err
+	}
+	distributeEvent, err := getLedgerEvent(distributorAbi, nodeDistributorContractName, feesDistributedEventName)
+	if err != nil {
+		return nil, err
+	}
+	distributeLogs, err := eth.GetLogs(rp, []common.Address{distributorAddress}, [][]common.Hash{{distributeEvent.ID}}, intervalSize, fromBlockBig, toBlockBig, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee distributor events: %w", err)
+	}
+	for _, log := range distributeLogs {
+		values := map[string]interface{}{}
+		if err := distributorAbi.UnpackIntoMap(values, feesDistributedEventName, log.Data); err != nil {
+			return nil, fmt.Errorf("error decoding fee distribution event in transaction %s: %w", log.TxHash.Hex(), err)
+		}
+		response.Entries = append(response.Entries, api.NodeLedgerEntry{
+			Block:     log.BlockNumber,
+			Type:      ledgerFeeDistribution,
+			Source:    log.Address,
+			TxHash:    log.TxHash,
+			EthAmount: getEventValue(values, "nodeAmount", "_nodeAmount"),
+		})
+	}
+
+	// Get the minipool balance distributions
+	if len(minipoolAddresses) > 0 {
+		minipoolAbi, err := rp.GetABI(minipoolDelegateContractName, nil)
+		if err != nil {
+			return nil, err
+		}
+		withdrawalEvent, err := getLedgerEvent(minipoolAbi, minipoolDelegateContractName, etherWithdrawalProcessedName)
+		if err != nil {
+			return nil, err
+		}
+		withdrawalLogs, err := eth.GetLogs(rp, minipoolAddresses, [][]common.Hash{{withdrawalEvent.ID}}, intervalSize, fromBlockBig, toBlockBig, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting minipool distribution events: %w", err)
+		}
+		for _, log := range withdrawalLogs {
+			values := map[string]interface{}{}
+			if err := minipoolAbi.UnpackIntoMap(values, etherWithdrawalProcessedName, log.Data); err != nil {
+				return nil, fmt.Errorf("error decoding minipool distribution event in transaction %s: %w", log.TxHash.Hex(), err)
+			}
+			response.Entries = append(response.Entries, api.NodeLedgerEntry{
+				Block:     log.BlockNumber,
+				Type:      ledgerMinipoolDistribution,
+				Source:    log.Address,
+				TxHash:    log.TxHash,
+				EthAmount: getEventValue(values, "nodeAmount", "_nodeAmount"),
+			})
+		}
+	}
+
+	// Get the gas cost of every transaction the node sent, including ones that didn't emit any of the events above
+	sentTxs, err := getSentTransactions(archiveRp, nodeAccount.Address, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	for _, sentTx := range sentTxs {
+		gasEntry, err := getGasEntry(rp, sentTx, nodeAccount.Address, headers)
+		if err != nil {
+			return nil, err
+		}
+		response.Entries = append(response.Entries, gasEntry)
+	}
+
+	// Get the skimmed and exited beacon balances of the node's minipools
+	if includeWithdrawals && len(minipoolAddresses) > 0 {
+		withdrawalEntries, err := getWithdrawalEntries(bc, minipoolAddresses, from, to)
+		if err != nil {
+			return nil, err
+		}
+		response.Entries = append(response.Entries, withdrawalEntries...)
+	}
+
+	// Sort the entries and fill in the times of the events
+	sort.SliceStable(response.Entries, func(i, j int) bool {
+		return response.Entries[i].Block < response.Entries[j].Block
+	})
+	for i, entry := range response.Entries {
+		if !entry.Time.IsZero() {
+			continue
+		}
+		header, err := getHeader(rp, entry.Block, headers)
+		if err != nil {
+			return nil, err
+		}
+		response.Entries[i].Time = time.Unix(int64(header.Time), 0)
+	}
+
+	// Add the RPL price at the time of each entry
+	if len(response.Entries) > 0 {
+		if err := addLedgerPrices(rp, archiveRp, response.Entries, from, intervalSize, headers); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get an event from a contract's ABI
+func getLedgerEvent(contractAbi *abi.ABI, contractName string, eventName string) (abi.Event, error) {
+	event, exists := contractAbi.Events[eventName]
+	if !exists {
+		return abi.Event{}, fmt.Errorf("the %s contract does not have a %s event", contractName, eventName)
+	}
+	return event, nil
+}
+
+// Get an integer value of a decoded event, trying each of the provided argument names
+func getEventValue(values map[string]interface{}, names ...string) *big.Int {
+	for _, name := range names {
+		if value, ok := values[name].(*big.Int); ok {
+			return value
+		}
+	}
+	return big.NewInt(0)
+}
+
+// Get the sum of an integer array value of a decoded event, trying each of the provided argument names
+func sumEventValues(values map[string]interface{}, names ...string) *big.Int {
+	sum := big.NewInt(0)
+	for _, name := range names {
+		if array, ok := values[name].([]*big.Int); ok {
+			for _, value := range array {
+				sum.Add(sum, value)
+			}
+			break
+		}
+	}
+	return sum
+}
+
+// Get a block header, caching it for later lookups
+func getHeader(rp *rocketpool.RocketPool, block uint64, headers map[uint64]*types.Header) (*types.Header, error) {
+	if header, exists := headers[block]; exists {
+		return header, nil
+	}
+	header, err := rp.Client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, fmt.Errorf("error getting header for block %d: %w", block, err)
+	}
+	headers[block] = header
+	return header, nil
+}
+
+// Get the first block with a timestamp at or after the provided time, or the block after the latest one if there isn't one yet
+func getFirstBlockAfter(rp *rocketpool.RocketPool, t time.Time, headers map[uint64]*types.Header) (uint64, error) {
+	latestBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	target := uint64(t.Unix())
+	if t.Unix() < 0 {
+		target = 0
+	}
+
+	low := uint64(0)
+	high := latestBlock + 1
+	for low < high {
+		mid := low + (high-low)/2
+		header, err := getHeader(rp, mid, headers)
+		if err != nil {
+			return 0, err
+		}
+		if header.Time < target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, nil
+}
+
+// Get the transactions the node sent in the block range. The node's nonce only grows, so the block each nonce was used in
+// can be found with a binary search over the node's historical nonce, without scanning every block in the range.
+func getSentTransactions(rp *rocketpool.RocketPool, nodeAddress common.Address, fromBlock uint64, toBlock uint64) ([]ledgerSentTx, error) {
+
+	client, ok := rp.Client.(ledgerBlockClient)
+	if !ok {
+		return nil, fmt.Errorf("the execution client cannot retrieve blocks")
+	}
+
+	// Get the nonce after a block, caching it for later lookups
+	nonces := map[uint64]uint64{}
+	getNonce := func(block uint64) (uint64, error) {
+		if nonce, exists := nonces[block]; exists {
+			return nonce, nil
+		}
+		nonce, err := rp.Client.NonceAt(context.Background(), nodeAddress, new(big.Int).SetUint64(block))
+		if err != nil {
+			return 0, fmt.Errorf("error getting node nonce at block %d: %w", block, err)
+		}
+		nonces[block] = nonce
+		return nonce, nil
+	}
+
+	// Get the nonces used in the range
+	startNonce := uint64(0)
+	if fromBlock > 0 {
+		var err error
+		startNonce, err = getNonce(fromBlock - 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	endNonce, err := getNonce(toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	sentTxs := []ledgerSentTx{}
+	low := fromBlock
+	for nonce := startNonce; nonce < endNonce; nonce++ {
+
+		// Find the first block after which the nonce has been used
+		high := toBlock
+		for low < high {
+			mid := low + (high-low)/2
+			blockNonce, err := getNonce(mid)
+			if err != nil {
+				return nil, err
+			}
+			if blockNonce > nonce {
+				high = mid
+			} else {
+				low = mid + 1
+			}
+		}
+
+		// Find the transaction in that block
+		block, err := client.BlockByNumber(context.Background(), new(big.Int).SetUint64(low))
+		if err != nil {
+			return nil, fmt.Errorf("error getting block %d: %w", low, err)
+		}
+		found := false
+		for _, tx := range block.Transactions() {
+			if tx.Nonce() != nonce {
+				continue
+			}
+			sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			if err != nil {
+				return nil, fmt.Errorf("error getting sender of transaction %s: %w", tx.Hash().Hex(), err)
+			}
+			if sender == nodeAddress {
+				sentTxs = append(sentTxs, ledgerSentTx{tx: tx, block: low})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find the transaction with nonce %d from %s in block %d", nonce, nodeAddress.Hex(), low)
+		}
+	}
+	return sentTxs, nil
+
+}
+
+// Get the gas cost of a transaction the node sent
+func getGasEntry(rp *rocketpool.RocketPool, sentTx ledgerSentTx, nodeAddress common.Address, headers map[uint64]*types.Header) (api.NodeLedgerEntry, error) {
+	tx := sentTx.tx
+	receipt, err := rp.Client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return api.NodeLedgerEntry{}, fmt.Errorf("error getting receipt of transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	// Get the price that was actually paid, which depends on the block's base fee for EIP-1559 transactions
+	gasPrice := tx.GasPrice()
+	if tx.Type() == types.DynamicFeeTxType {
+		header, err := getHeader(rp, sentTx.block, headers)
+		if err != nil {
+			return api.NodeLedgerEntry{}, err
+		}
+		if header.BaseFee != nil {
+			gasPrice = new(big.Int).Add(header.BaseFee, tx.GasTipCap())
+			if gasPrice.Cmp(tx.GasFeeCap()) > 0 {
+				gasPrice = tx.GasFeeCap()
+			}
+		}
+	}
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+
+	// Contract deployments don't have a recipient, so they're attributed to the node itself
+	source := nodeAddress
+	if tx.To() != nil {
+		source = *tx.To()
+	}
+	return api.NodeLedgerEntry{
+		Block:     sentTx.block,
+		Type:      ledgerGas,
+		Source:    source,
+		TxHash:    tx.Hash(),
+		EthAmount: cost.Neg(cost),
+	}, nil
+}
+
+// Scan every beacon block in the time range for withdrawals to the provided minipools.
+// The amounts are the full withdrawals credited to the minipools, including the pool stakers' share, so they're
+// recorded as withdrawn amounts rather than income; the node's share is counted when the minipool distributes its balance.
+func getWithdrawalEntries(bc beacon.Client, minipoolAddresses []common.Address, from time.Time, to time.Time) ([]api.NodeLedgerEntry, error) {
+
+	// Get the slot range
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	if eth2Config.SecondsPerSlot == 0 {
+		return nil, fmt.Errorf("the number of seconds per slot cannot be 0")
+	}
+	genesisTime := time.Unix(int64(eth2Config.GenesisTime), 0)
+	getSlot := func(t time.Time) uint64 {
+		if !t.After(genesisTime) {
+			return 0
+		}
+		return uint64(t.Sub(genesisTime).Seconds()+float64(eth2Config.SecondsPerSlot)-1) / eth2Config.SecondsPerSlot
+	}
+	startSlot := getSlot(from)
+	endSlot := getSlot(to)
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	if headSlot := (head.Epoch + 1) * eth2Config.SlotsPerEpoch; endSlot > headSlot {
+		endSlot = headSlot
+	}
+
+	minipools := map[common.Address]bool{}
+	for _, address := range minipoolAddresses {
+		minipools[address] = true
+	}
+
+	// Get the blocks in batches
+	entries := []api.NodeLedgerEntry{}
+	for batchStart := startSlot; batchStart < endSlot; batchStart += ledgerWithdrawalScanConcurrency {
+		batchEnd := batchStart + ledgerWithdrawalScanConcurrency
+		if batchEnd > endSlot {
+			batchEnd = endSlot
+		}
+		blocks := make([]beacon.BeaconBlock, batchEnd-batchStart)
+		found := make([]bool, batchEnd-batchStart)
+
+		var wg errgroup.Group
+		for slot := batchStart; slot < batchEnd; slot++ {
+			slot := slot
+			wg.Go(func() error {
+				block, exists, err := bc.GetBeaconBlock(fmt.Sprint(slot))
+				if err != nil {
+					return fmt.Errorf("error getting beacon block %d: %w", slot, err)
+				}
+				blocks[slot-batchStart] = block
+				found[slot-batchStart] = exists
+				return nil
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, err
+		}
+
+		for i, block := range blocks {
+			if !found[i] {
+				continue
+			}
+			for _, withdrawal := range block.Withdrawals {
+				if !minipools[withdrawal.Address] {
+					continue
+				}
+				amount := new(big.Int).SetUint64(withdrawal.Amount)
+				entries = append(entries, api.NodeLedgerEntry{
+					Time:            genesisTime.Add(time.Duration(block.Slot*eth2Config.SecondsPerSlot) * time.Second),
+					Block:           block.ExecutionBlockNumber,
+					Type:            ledgerBeaconWithdrawal,
+					Source:          withdrawal.Address,
+					WithdrawnAmount: amount.Mul(amount, big.NewInt(1e9)),
+				})
+			}
+		}
+	}
+	return entries, nil
+
+}
+
+// Add the RPL price reported by the oracle DAO at the time of each entry; the entries must be sorted by block.
+// The archive client is used to read the price directly if it wasn't updated during the lookback period.
+func addLedgerPrices(rp *rocketpool.RocketPool, archiveRp *rocketpool.RocketPool, entries []api.NodeLedgerEntry, from time.Time, intervalSize *big.Int, headers map[uint64]*types.Header) error {
+
+	// Look back far enough to find the price that was in effect at the start of the range
+	priceFromBlock, err := getFirstBlockAfter(rp, from.Add(-ledgerPriceLookback), headers)
+	if err != nil {
+		return err
+	}
+	networkPrices, err := rp.GetContract(networkPricesContractName, nil)
+	if err != nil {
+		return err
+	}
+	pricesEvent, err := getLedgerEvent(networkPrices.ABI, networkPricesContractName, pricesUpdatedEventName)
+	if err != nil {
+		return err
+	}
+	logs, err := eth.FilterContractLogs(rp, networkPricesContractName, eth.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(priceFromBlock),
+		ToBlock:   new(big.Int).SetUint64(entries[len(entries)-1].Block),
+		Topics:    [][]common.Hash{{pricesEvent.ID}},
+	}, intervalSize, nil)
+	if err != nil {
+		return fmt.Errorf("error getting RPL price events: %w", err)
+	}
+
+	// Walk the entries and the price updates together
+	var price *big.Int
+	if len(logs) == 0 || logs[0].BlockNumber > entries[0].Block {
+		price, err = network.GetRPLPrice(archiveRp, &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(entries[0].Block)})
+		if err != nil {
+			return fmt.Errorf("error getting RPL price at block %d: %w", entries[0].Block, err)
+		}
+	}
+	next := 0
+	for i := range entries {
+		for next < len(logs) && logs[next].BlockNumber <= entries[i].Block {
+			values := map[string]interface{}{}
+			if err := networkPrices.ABI.UnpackIntoMap(values, pricesUpdatedEventName, logs[next].Data); err != nil {
+				return fmt.Errorf("error decoding RPL price event in transaction %s: %w", logs[next].TxHash.Hex(), err)
+			}
+			price = getEventValue(values, "rplPrice", "_rplPrice")
+			next++
+		}
+		entries[i].RplPrice = price
+	}
+	return nil
+
+}
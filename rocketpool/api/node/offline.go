@@ -0,0 +1,197 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/Seb369888/poolsea-go/node"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/storage"
+	"github.com/Seb369888/poolsea-go/tokens"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	"github.com/Seb369888/smartnode/shared/utils/eth1"
+	hexutils "github.com/Seb369888/smartnode/shared/utils/hex"
+)
+
+func buildApproveRplTransaction(c *cli.Context, nodeAddress common.Address, amountWei *big.Int) (*api.BuildOfflineTransactionResponse, error) {
+
+	// Get services; the node wallet isn't needed because the transaction is signed elsewhere
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOfflineNodeRegistered(rp, nodeAddress); err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BuildOfflineTransactionResponse{}
+
+	// Get staking contract address
+	rocketNodeStakingAddress, err := rp.GetAddress("poolseaNodeStaking", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the approval
+	transactor, err := eth1.GetUnsignedTransactor(c, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tokens.ApproveRPL(rp, *rocketNodeStakingAddress, amountWei, transactor.Opts); err != nil {
+		return nil, err
+	}
+	response.Transaction, err = transactor.GetOfflineTransaction("Approve the staking contract to use the node's RPL")
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func buildStakeRplTransaction(c *cli.Context, nodeAddress common.Address, amountWei *big.Int) (*api.BuildOfflineTransactionResponse, error) {
+
+	// Get services; the node wallet isn't needed because the transaction is signed elsewhere
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOfflineNodeRegistered(rp, nodeAddress); err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BuildOfflineTransactionResponse{}
+
+	// Build the stake
+	transactor, err := eth1.GetUnsignedTransactor(c, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := node.StakeRPL(rp, amountWei, transactor.Opts); err != nil {
+		return nil, err
+	}
+	response.Transaction, err = transactor.GetOfflineTransaction(fmt.Sprintf("Stake %s wei of RPL", amountWei.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func buildSetWithdrawalAddressTransaction(c *cli.Context, nodeAddress common.Address, withdrawalAddress common.Address, confirm bool) (*api.BuildOfflineTransactionResponse, error) {
+
+	// Get services; the node wallet isn't needed because the transaction is signed elsewhere
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOfflineNodeRegistered(rp, nodeAddress); err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BuildOfflineTransactionResponse{}
+
+	// Make sure the current withdrawal address is set to the node address
+	currentAddress, err := storage.GetNodeWithdrawalAddress(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if currentAddress != nodeAddress {
+		return nil, fmt.Errorf("This wallet's current withdrawal address is %s, "+
+			"so you cannot call set-withdrawal-address from the node.", currentAddress.String())
+	}
+
+	// Build the withdrawal address change
+	transactor, err := eth1.GetUnsignedTransactor(c, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := storage.SetWithdrawalAddress(rp, nodeAddress, withdrawalAddress, confirm, transactor.Opts); err != nil {
+		return nil, err
+	}
+	description := fmt.Sprintf("Set the node's pending withdrawal address to %s", withdrawalAddress.Hex())
+	if confirm {
+		description = fmt.Sprintf("Set the node's withdrawal address to %s immediately", withdrawalAddress.Hex())
+	}
+	response.Transaction, err = transactor.GetOfflineTransaction(description)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func broadcastTransaction(c *cli.Context, signedTx string) (*api.BroadcastTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastTransactionResponse{}
+
+	// Decode the transaction
+	signedTx = hexutils.RemovePrefix(signedTx)
+	txBytes, err := hex.DecodeString(signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing TX bytes [%s]: %w", signedTx, err)
+	}
+	tx := types.Transaction{}
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
+	}
+	if _, r, s := tx.RawSignatureValues(); r.Sign() == 0 && s.Sign() == 0 {
+		return nil, fmt.Errorf("The transaction has not been signed")
+	}
+
+	// Submit it
+	if err := ec.SendTransaction(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("Error submitting TX: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
+
+// Make sure the node the transaction is built for is registered
+func checkOfflineNodeRegistered(rp *rocketpool.RocketPool, nodeAddress common.Address) error {
+	nodeRegistered, err := node.GetNodeExists(rp, nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	if !nodeRegistered {
+		return fmt.Errorf("Node %s is not registered with poolsea Pool.", nodeAddress.Hex())
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/types/api"
 	"github.com/Seb369888/smartnode/shared/utils/eth1"
@@ -250,6 +251,10 @@ func stakeRpl(c *cli.Context, amountWei *big.Int) (*api.NodeStakeRplStakeRespons
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.NodeStakeRplStakeResponse{}
@@ -263,6 +268,11 @@ func stakeRpl(c *cli.Context, amountWei *big.Int) (*api.NodeStakeRplStakeRespons
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
+
+	// Use the private transaction route for large stakes if it's enabled
+	if cfg.Smartnode.IsPrivateRplStake(amountWei) {
+		opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_RplStake)
+	}
 	hash, err := node.StakeRPL(rp, amountWei, opts)
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	"github.com/Seb369888/smartnode/shared/types/api"
 	"github.com/Seb369888/smartnode/shared/utils/eth1"
 )
@@ -84,6 +85,9 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, conf
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
 
+	// Use the private transaction route if it's enabled for withdrawal address changes
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_WithdrawalAddress)
+
 	// Get the node's account
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
@@ -190,6 +194,9 @@ func confirmWithdrawalAddress(c *cli.Context) (*api.ConfirmNodeWithdrawalAddress
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
 
+	// Use the private transaction route if it's enabled for withdrawal address changes
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_WithdrawalAddress)
+
 	// Get the node's account
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
@@ -329,701 +329,253 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			},
 
 			{
-				Name:      "can-execute-proposal",
-				Usage:     "Check whether the node can execute a proposal",
-				UsageText: "poolsea api odao can-execute-proposal proposal-id",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalId, err := cliutils.ValidatePositiveUint("proposal ID", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
-					return nil
-
-				},
-			},
-			{
-				Name:      "execute-proposal",
-				Aliases:   []string{"x"},
-				Usage:     "Execute a proposal",
-				UsageText: "poolsea api odao execute-proposal proposal-id",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalId, err := cliutils.ValidatePositiveUint("proposal ID", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-join",
-				Usage:     "Check whether the node can join the oracle DAO",
-				UsageText: "poolsea api odao can-join",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 0); err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canJoin(c))
-					return nil
-
-				},
-			},
-			{
-				Name:      "join-approve-rpl",
-				Aliases:   []string{"j1"},
-				Usage:     "Approves the RPL bond transfer prior to join the oracle DAO",
-				UsageText: "poolsea api odao join-approve-rpl",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 0); err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(approveRpl(c))
-					return nil
-
-				},
-			},
-			{
-				Name:      "join",
-				Aliases:   []string{"j2"},
-				Usage:     "Join the oracle DAO (requires an executed invite proposal)",
-				UsageText: "poolsea api odao join tx-hash",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(waitForApprovalAndJoin(c, hash))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-leave",
-				Usage:     "Check whether the node can leave the oracle DAO",
-				UsageText: "poolsea api odao can-leave",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 0); err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canLeave(c))
-					return nil
-
-				},
-			},
-			{
-				Name:      "leave",
-				Aliases:   []string{"e"},
-				Usage:     "Leave the oracle DAO (requires an executed leave proposal)",
-				UsageText: "poolsea api odao leave bond-refund-address",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					bondRefundAddress, err := cliutils.ValidateAddress("bond refund address", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(leave(c, bondRefundAddress))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-members-quorum",
-				Usage:     "Check whether the node can propose the members.quorum setting",
-				UsageText: "poolsea api odao can-propose-members-quorum value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					quorum, err := cliutils.ValidateFraction("quorum", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingMembersQuorum(c, quorum))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-members-quorum",
-				Usage:     "Propose updating the members.quorum setting",
-				UsageText: "poolsea api odao propose-members-quorum value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					quorum, err := cliutils.ValidateFraction("quorum", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingMembersQuorum(c, quorum))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-members-rplbond",
-				Usage:     "Check whether the node can propose the members.rplbond setting",
-				UsageText: "poolsea api odao can-propose-members-rplbond value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					bondAmountWei, err := cliutils.ValidateWeiAmount("RPL bond amount", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingMembersRplBond(c, bondAmountWei))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-members-rplbond",
-				Usage:     "Propose updating the members.rplbond setting",
-				UsageText: "poolsea api odao propose-members-rplbond value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					bondAmountWei, err := cliutils.ValidateWeiAmount("RPL bond amount", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-members-minipool-unbonded-max",
-				Usage:     "Check whether the node can propose the members.minipool.unbonded.max setting",
-				UsageText: "poolsea api odao can-propose-members-minipool-unbonded-max value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					unbondedMinipoolMax, err := cliutils.ValidateUint("maximum unbonded minipool count", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-members-minipool-unbonded-max",
-				Usage:     "Propose updating the members.minipool.unbonded.max setting",
-				UsageText: "poolsea api odao propose-members-minipool-unbonded-max value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					unbondedMinipoolMax, err := cliutils.ValidateUint("maximum unbonded minipool count", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-proposal-cooldown",
-				Usage:     "Check whether the node can propose the proposal.cooldown setting",
-				UsageText: "poolsea api odao can-propose-proposal-cooldown value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalCooldownBlocks, err := cliutils.ValidateUint("proposal cooldown period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingProposalCooldown(c, proposalCooldownBlocks))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-proposal-cooldown",
-				Usage:     "Propose updating the proposal.cooldown setting",
-				UsageText: "poolsea api odao propose-proposal-cooldown value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalCooldownBlocks, err := cliutils.ValidateUint("proposal cooldown period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-proposal-vote-timespan",
-				Usage:     "Check whether the node can propose the proposal.vote.time setting",
-				UsageText: "poolsea api odao can-propose-proposal-vote-timespan value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalVoteTimespan, err := cliutils.ValidateUint("proposal voting period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-proposal-vote-timespan",
-				Usage:     "Propose updating the proposal.vote.time setting",
-				UsageText: "poolsea api odao propose-proposal-vote-timespan value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalVoteTimespan, err := cliutils.ValidateUint("proposal voting period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-proposal-vote-delay-timespan",
-				Usage:     "Check whether the node can propose the proposal.vote.delay.time setting",
-				UsageText: "poolsea api odao can-propose-proposal-vote-delay-timespan value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalDelayTimespan, err := cliutils.ValidateUint("proposal delay period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-proposal-vote-delay-timespan",
-				Usage:     "Propose updating the proposal.vote.delay.time setting",
-				UsageText: "poolsea api odao propose-proposal-vote-delay-timespan value",
+				Name:      "can-vote-proposals",
+				Usage:     "Check whether the node can vote on several proposals",
+				UsageText: "poolsea api odao can-vote-proposals votes",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					proposalDelayTimespan, err := cliutils.ValidateUint("proposal delay period", c.Args().Get(0))
+					votes, err := parseProposalVotes(c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
+					api.PrintResponse(canVoteOnProposals(c, votes))
 					return nil
 
 				},
 			},
-
 			{
-				Name:      "can-propose-proposal-execute-timespan",
-				Usage:     "Check whether the node can propose the proposal.execute.time setting",
-				UsageText: "poolsea api odao can-propose-proposal-execute-timespan value",
+				Name:      "vote-proposals",
+				Usage:     "Vote on several proposals, using sequential nonces",
+				UsageText: "poolsea api odao vote-proposals votes",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					proposalExecuteTimespan, err := cliutils.ValidateUint("proposal execution period", c.Args().Get(0))
+					votes, err := parseProposalVotes(c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
+					api.PrintResponse(voteOnProposals(c, votes))
 					return nil
 
 				},
 			},
 			{
-				Name:      "propose-proposal-execute-timespan",
-				Usage:     "Propose updating the proposal.execute.time setting",
-				UsageText: "poolsea api odao propose-proposal-execute-timespan value",
+				Name:      "build-vote-proposals",
+				Usage:     "Build unsigned transactions that vote on several proposals with sequential nonces, to be signed offline",
+				UsageText: "poolsea api odao build-vote-proposals node-address votes",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalExecuteTimespan, err := cliutils.ValidateUint("proposal execution period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(proposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
-					return nil
-
-				},
-			},
-
-			{
-				Name:      "can-propose-proposal-action-timespan",
-				Usage:     "Check whether the node can propose the proposal.action.time setting",
-				UsageText: "poolsea api odao can-propose-proposal-action-timespan value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
 						return err
 					}
-					proposalActionTimespan, err := cliutils.ValidateUint("proposal action period", c.Args().Get(0))
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
-
-					// Run
-					api.PrintResponse(canProposeSettingProposalActionTimespan(c, proposalActionTimespan))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-proposal-action-timespan",
-				Usage:     "Propose updating the proposal.action.time setting",
-				UsageText: "poolsea api odao propose-proposal-action-timespan value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					proposalActionTimespan, err := cliutils.ValidateUint("proposal action period", c.Args().Get(0))
+					votes, err := parseProposalVotes(c.Args().Get(1))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalActionTimespan(c, proposalActionTimespan))
+					api.PrintResponse(buildVoteOnProposalsTransactions(c, nodeAddress, votes))
 					return nil
 
 				},
 			},
 
 			{
-				Name:      "can-propose-scrub-period",
-				Usage:     "Check whether the node can propose the minipool.scrub.period setting",
-				UsageText: "poolsea api odao can-propose-scrub-period value",
+				Name:      "can-execute-proposal",
+				Usage:     "Check whether the node can execute a proposal",
+				UsageText: "poolsea api odao can-execute-proposal proposal-id",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					scrubPeriod, err := cliutils.ValidateUint("scrub period", c.Args().Get(0))
+					proposalId, err := cliutils.ValidatePositiveUint("proposal ID", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPeriod(c, scrubPeriod))
+					api.PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
 			},
 			{
-				Name:      "propose-scrub-period",
-				Usage:     "Propose updating the minipool.scrub.period setting",
-				UsageText: "poolsea api odao propose-scrub-period value",
+				Name:      "execute-proposal",
+				Aliases:   []string{"x"},
+				Usage:     "Execute a proposal",
+				UsageText: "poolsea api odao execute-proposal proposal-id",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					scrubPeriod, err := cliutils.ValidateUint("scrub period", c.Args().Get(0))
+					proposalId, err := cliutils.ValidatePositiveUint("proposal ID", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPeriod(c, scrubPeriod))
+					api.PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
 			},
 
 			{
-				Name:      "can-propose-promotion-scrub-period",
-				Usage:     "Check whether the node can propose the minipool.promotion.scrub.period setting",
-				UsageText: "poolsea api odao can-propose-promotion-scrub-period value",
-				Action: func(c *cli.Context) error {
-
-					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					scrubPeriod, err := cliutils.ValidateUint("promotion scrub period", c.Args().Get(0))
-					if err != nil {
-						return err
-					}
-
-					// Run
-					api.PrintResponse(canProposeSettingPromotionScrubPeriod(c, scrubPeriod))
-					return nil
-
-				},
-			},
-			{
-				Name:      "propose-promotion-scrub-period",
-				Usage:     "Propose updating the minipool.promotion.scrub.period setting",
-				UsageText: "poolsea api odao propose-promotion-scrub-period value",
+				Name:      "can-join",
+				Usage:     "Check whether the node can join the oracle DAO",
+				UsageText: "poolsea api odao can-join",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					scrubPeriod, err := cliutils.ValidateUint("promotion scrub period", c.Args().Get(0))
-					if err != nil {
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingPromotionScrubPeriod(c, scrubPeriod))
+					api.PrintResponse(canJoin(c))
 					return nil
 
 				},
 			},
-
 			{
-				Name:      "can-propose-scrub-penalty-enabled",
-				Usage:     "Check whether the node can propose the minipool.scrub.penalty.enabled setting",
-				UsageText: "poolsea api odao can-propose-scrub-penalty-enabled value",
+				Name:      "join-approve-rpl",
+				Aliases:   []string{"j1"},
+				Usage:     "Approves the RPL bond transfer prior to join the oracle DAO",
+				UsageText: "poolsea api odao join-approve-rpl",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					enabled, err := cliutils.ValidateBool("scrub penalty enabled", c.Args().Get(0))
-					if err != nil {
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPenaltyEnabled(c, enabled))
+					api.PrintResponse(approveRpl(c))
 					return nil
 
 				},
 			},
 			{
-				Name:      "propose-scrub-penalty-enabled",
-				Usage:     "Propose updating the minipool.scrub.penalty.enabled setting",
-				UsageText: "poolsea api odao propose-scrub-penalty-enabled value",
+				Name:      "join",
+				Aliases:   []string{"j2"},
+				Usage:     "Join the oracle DAO (requires an executed invite proposal)",
+				UsageText: "poolsea api odao join tx-hash",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					enabled, err := cliutils.ValidateBool("scrub penalty enabled", c.Args().Get(0))
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPenaltyEnabled(c, enabled))
+					api.PrintResponse(waitForApprovalAndJoin(c, hash))
 					return nil
 
 				},
 			},
 
 			{
-				Name:      "can-propose-bond-reduction-window-start",
-				Usage:     "Check whether the node can propose the minipool.bond.reduction.window.start setting",
-				UsageText: "poolsea api odao can-propose-bond-reduction-window-start value",
+				Name:      "can-leave",
+				Usage:     "Check whether the node can leave the oracle DAO",
+				UsageText: "poolsea api odao can-leave",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
-						return err
-					}
-					windowStart, err := cliutils.ValidateUint("window start", c.Args().Get(0))
-					if err != nil {
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowStart(c, windowStart))
+					api.PrintResponse(canLeave(c))
 					return nil
 
 				},
 			},
 			{
-				Name:      "propose-bond-reduction-window-start",
-				Usage:     "Propose updating the minipool.bond.reduction.window.start setting",
-				UsageText: "poolsea api odao propose-bond-reduction-window-start value",
+				Name:      "leave",
+				Aliases:   []string{"e"},
+				Usage:     "Leave the oracle DAO (requires an executed leave proposal)",
+				UsageText: "poolsea api odao leave bond-refund-address",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 1); err != nil {
 						return err
 					}
-					windowStart, err := cliutils.ValidateUint("window start", c.Args().Get(0))
+					bondRefundAddress, err := cliutils.ValidateAddress("bond refund address", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowStart(c, windowStart))
+					api.PrintResponse(leave(c, bondRefundAddress))
 					return nil
 
 				},
 			},
 
 			{
-				Name:      "can-propose-bond-reduction-window-length",
-				Usage:     "Check whether the node can propose the minipool.bond.reduction.window.length setting",
-				UsageText: "poolsea api odao can-propose-bond-reduction-window-length value",
+				Name:      "can-propose-setting",
+				Usage:     "Check whether the node can propose a new value for an oracle DAO setting",
+				UsageText: "poolsea api odao can-propose-setting setting value",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
 						return err
 					}
-					windowLength, err := cliutils.ValidateUint("window length", c.Args().Get(0))
+					value, err := cliutils.ValidateWeiAmount("setting value", c.Args().Get(1))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowLength(c, windowLength))
+					api.PrintResponse(canProposeSetting(c, c.Args().Get(0), value))
 					return nil
 
 				},
 			},
 			{
-				Name:      "propose-bond-reduction-window-length",
-				Usage:     "Propose updating the minipool.bond.reduction.window.length setting",
-				UsageText: "poolsea api odao propose-bond-reduction-window-length value",
+				Name:      "propose-setting",
+				Usage:     "Propose a new value for an oracle DAO setting",
+				UsageText: "poolsea api odao propose-setting setting value",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
 						return err
 					}
-					windowLength, err := cliutils.ValidateUint("window length", c.Args().Get(0))
+					value, err := cliutils.ValidateWeiAmount("setting value", c.Args().Get(1))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowLength(c, windowLength))
+					api.PrintResponse(proposeSetting(c, c.Args().Get(0), value))
 					return nil
 
 				},
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	"github.com/Seb369888/smartnode/shared/types/api"
 	"github.com/Seb369888/smartnode/shared/utils/eth1"
 )
@@ -107,6 +108,9 @@ func executeProposal(c *cli.Context, proposalId uint64) (*api.ExecuteTNDAOPropos
 		return nil, err
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Override the provided pending TX if requested
 	err = eth1.CheckForNonceOverride(c, opts)
 	if err != nil {
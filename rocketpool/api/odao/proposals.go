@@ -36,7 +36,7 @@ func getProposals(c *cli.Context) (*api.TNDAOProposalsResponse, error) {
 	}
 
 	// Get proposals
-	proposals, err := dao.GetDAOProposalsWithMember(rp, "poolseaDAONodeTrustedProposals", nodeAccount.Address, nil)
+	proposals, err := dao.GetDAOProposalsWithMember(rp, TNDAOProposalsName, nodeAccount.Address, nil)
 	if err != nil {
 		return nil, err
 	}
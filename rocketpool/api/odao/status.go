@@ -2,7 +2,6 @@ package odao
 
 import (
 	"github.com/Seb369888/poolsea-go/dao/trustednode"
-	rptypes "github.com/Seb369888/poolsea-go/types"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
@@ -92,27 +91,9 @@ func getStatus(c *cli.Context) (*api.TNDAOStatusResponse, error) {
 
 	// Get proposal counts
 	wg.Go(func() error {
-		proposalStates, err := getProposalStates(rp)
+		proposalCounts, err := GetProposalCounts(rp, TNDAOProposalsName)
 		if err == nil {
-			response.ProposalCounts.Total = len(proposalStates)
-			for _, state := range proposalStates {
-				switch state {
-				case rptypes.Pending:
-					response.ProposalCounts.Pending++
-				case rptypes.Active:
-					response.ProposalCounts.Active++
-				case rptypes.Cancelled:
-					response.ProposalCounts.Cancelled++
-				case rptypes.Defeated:
-					response.ProposalCounts.Defeated++
-				case rptypes.Succeeded:
-					response.ProposalCounts.Succeeded++
-				case rptypes.Expired:
-					response.ProposalCounts.Expired++
-				case rptypes.Executed:
-					response.ProposalCounts.Executed++
-				}
-			}
+			response.ProposalCounts = proposalCounts
 		}
 		return err
 	})
@@ -10,11 +10,16 @@ import (
 	rptypes "github.com/Seb369888/poolsea-go/types"
 	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/Seb369888/smartnode/shared/types/api"
 )
 
 // Settings
 const ProposalStatesBatchSize = 50
 
+// The name of the oracle DAO in the proposal store
+const TNDAOProposalsName = "poolseaDAONodeTrustedProposals"
+
 // Check if the proposal cooldown for an oracle node is active
 func getProposalCooldownActive(rp *rocketpool.RocketPool, nodeAddress common.Address) (bool, error) {
 
@@ -111,13 +116,13 @@ func getMembersCanLeave(rp *rocketpool.RocketPool) (bool, error) {
 
 }
 
-// Get all proposal states
-func getProposalStates(rp *rocketpool.RocketPool) ([]rptypes.ProposalState, error) {
+// Get the states of all proposals made by a DAO, keyed by proposal ID
+func GetProposalStates(rp *rocketpool.RocketPool, daoName string) (map[uint64]rptypes.ProposalState, error) {
 
 	// Get proposal IDs
-	proposalIds, err := dao.GetDAOProposalIDs(rp, "poolseaDAONodeTrustedProposals", nil)
+	proposalIds, err := dao.GetDAOProposalIDs(rp, daoName, nil)
 	if err != nil {
-		return []rptypes.ProposalState{}, err
+		return nil, err
 	}
 
 	// Load proposal states in batches
@@ -144,12 +149,52 @@ func getProposalStates(rp *rocketpool.RocketPool) ([]rptypes.ProposalState, erro
 			})
 		}
 		if err := wg.Wait(); err != nil {
-			return []rptypes.ProposalState{}, err
+			return nil, err
 		}
 
 	}
 
 	// Return
-	return states, nil
+	stateMap := make(map[uint64]rptypes.ProposalState, len(proposalIds))
+	for pi, proposalId := range proposalIds {
+		stateMap[proposalId] = states[pi]
+	}
+	return stateMap, nil
+
+}
+
+// Get the number of proposals made by a DAO in each state
+func GetProposalCounts(rp *rocketpool.RocketPool, daoName string) (api.DAOProposalCounts, error) {
+
+	// Get proposal states
+	counts := api.DAOProposalCounts{}
+	proposalStates, err := GetProposalStates(rp, daoName)
+	if err != nil {
+		return counts, err
+	}
+
+	// Count them
+	counts.Total = len(proposalStates)
+	for _, state := range proposalStates {
+		switch state {
+		case rptypes.Pending:
+			counts.Pending++
+		case rptypes.Active:
+			counts.Active++
+		case rptypes.Cancelled:
+			counts.Cancelled++
+		case rptypes.Defeated:
+			counts.Defeated++
+		case rptypes.Succeeded:
+			counts.Succeeded++
+		case rptypes.Expired:
+			counts.Expired++
+		case rptypes.Executed:
+			counts.Executed++
+		}
+	}
+
+	// Return
+	return counts, nil
 
 }
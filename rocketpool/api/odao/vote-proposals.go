@@ -0,0 +1,229 @@
+package odao
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Seb369888/poolsea-go/dao/trustednode"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+	"github.com/Seb369888/smartnode/shared/utils/eth1"
+)
+
+func canVoteOnProposals(c *cli.Context, votes []api.TNDAOProposalVote) (*api.CanVoteOnTNDAOProposalsResponse, error) {
+
+	// Response
+	response := api.CanVoteOnTNDAOProposalsResponse{
+		Votes: make([]api.CanVoteOnTNDAOProposalResponse, len(votes)),
+	}
+
+	// Check each vote; each one is sent with its own gas limit, so the batch needs the largest of them
+	for i, vote := range votes {
+		canVote, err := canVoteOnProposal(c, vote.ProposalId)
+		if err != nil {
+			return nil, fmt.Errorf("error checking proposal %d: %w", vote.ProposalId, err)
+		}
+		response.Votes[i] = *canVote
+		if canVote.CanVote && canVote.GasInfo.SafeGasLimit > response.GasInfo.SafeGasLimit {
+			response.GasInfo = canVote.GasInfo
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func voteOnProposals(c *cli.Context, votes []api.TNDAOProposalVote) (*api.VoteOnTNDAOProposalsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.VoteOnTNDAOProposalsResponse{
+		Votes: make([]api.TNDAOProposalVoteResult, len(votes)),
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
+	// Use the nonce override for the first vote if one was provided, and the ones after it for the rest
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+	nonceOverride := opts.Nonce
+	gasLimitOverride := opts.GasLimit
+
+	// Submit the votes
+	for i, vote := range votes {
+		result := api.TNDAOProposalVoteResult{
+			ProposalId: vote.ProposalId,
+			Support:    vote.Support,
+		}
+		response.Votes[i] = result
+
+		// Get the vote's own gas limit
+		opts.Nonce = nil
+		opts.GasLimit = gasLimitOverride
+		if opts.GasLimit == 0 {
+			gasInfo, err := trustednode.EstimateVoteOnProposalGas(rp, vote.ProposalId, vote.Support, opts)
+			if err != nil {
+				response.Votes[i].Error = fmt.Sprintf("Could not estimate the gas required to vote: %s", err.Error())
+				continue
+			}
+			opts.GasLimit = gasInfo.SafeGasLimit
+		}
+
+		// Get its nonce; a reserved nonce is released by the nonce manager if the vote fails to submit
+		if nonceOverride != nil {
+			result.Nonce = nonceOverride.Uint64()
+		} else {
+			result.Nonce, err = ec.PendingNonceAt(opts.Context, opts.From)
+			if err != nil {
+				response.Votes[i].Error = fmt.Sprintf("Could not get the next nonce for the node account: %s", err.Error())
+				continue
+			}
+		}
+		opts.Nonce = new(big.Int).SetUint64(result.Nonce)
+
+		// Vote
+		hash, err := trustednode.VoteOnProposal(rp, vote.ProposalId, vote.Support, opts)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.TxHash = hash
+			if nonceOverride != nil {
+				nonceOverride = new(big.Int).SetUint64(result.Nonce + 1)
+			}
+		}
+		response.Votes[i] = result
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func buildVoteOnProposalsTransactions(c *cli.Context, nodeAddress common.Address, votes []api.TNDAOProposalVote) (*api.BuildOfflineTransactionsResponse, error) {
+
+	// Get services; the node wallet isn't needed because the votes are signed elsewhere
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the node is a member
+	isMember, err := trustednode.GetMemberExists(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, fmt.Errorf("Node %s is not a member of the oracle DAO.", nodeAddress.Hex())
+	}
+
+	// Response
+	response := api.BuildOfflineTransactionsResponse{
+		Transactions: make([]api.OfflineTransaction, len(votes)),
+	}
+
+	// Get transactor
+	transactor, err := eth1.GetUnsignedTransactor(c, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	opts := transactor.Opts
+	nonceOverride := opts.Nonce
+	gasLimitOverride := opts.GasLimit
+
+	// Build the votes with sequential nonces
+	for i, vote := range votes {
+
+		// Get the vote's own gas limit
+		opts.Nonce = nonceOverride
+		opts.GasLimit = gasLimitOverride
+		if opts.GasLimit == 0 {
+			gasInfo, err := trustednode.EstimateVoteOnProposalGas(rp, vote.ProposalId, vote.Support, opts)
+			if err != nil {
+				return nil, fmt.Errorf("Could not estimate the gas required to vote on proposal %d: %w", vote.ProposalId, err)
+			}
+			opts.GasLimit = gasInfo.SafeGasLimit
+		}
+
+		// Build the vote
+		if _, err := trustednode.VoteOnProposal(rp, vote.ProposalId, vote.Support, opts); err != nil {
+			return nil, fmt.Errorf("Could not build the vote on proposal %d: %w", vote.ProposalId, err)
+		}
+		description := fmt.Sprintf("Vote against oracle DAO proposal %d", vote.ProposalId)
+		if vote.Support {
+			description = fmt.Sprintf("Vote in support of oracle DAO proposal %d", vote.ProposalId)
+		}
+		response.Transactions[i], err = transactor.GetOfflineTransaction(description)
+		if err != nil {
+			return nil, err
+		}
+		nonceOverride = new(big.Int).SetUint64(uint64(response.Transactions[i].Nonce) + 1)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Parse a list of proposal votes in the form "id:support,id:support"
+func parseProposalVotes(value string) ([]api.TNDAOProposalVote, error) {
+	votes := []api.TNDAOProposalVote{}
+	seen := map[uint64]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		elements := strings.Split(entry, ":")
+		if len(elements) != 2 {
+			return nil, fmt.Errorf("Invalid proposal vote '%s' - must be in the form 'proposal-id:support'", entry)
+		}
+		proposalId, err := cliutils.ValidatePositiveUint("proposal ID", elements[0])
+		if err != nil {
+			return nil, err
+		}
+		support, err := cliutils.ValidateBool("support", elements[1])
+		if err != nil {
+			return nil, err
+		}
+		if seen[proposalId] {
+			return nil, fmt.Errorf("Proposal %d is listed more than once", proposalId)
+		}
+		seen[proposalId] = true
+		votes = append(votes, api.TNDAOProposalVote{
+			ProposalId: proposalId,
+			Support:    support,
+		})
+	}
+	return votes, nil
+}
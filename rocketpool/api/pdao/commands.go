@@ -0,0 +1,37 @@
+package pdao
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/utils/api"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+)
+
+// Register subcommands
+func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage the Poolsea protocol DAO",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "get-settings",
+				Usage:     "Get the current protocol DAO settings",
+				UsageText: "poolsea api pdao get-settings",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSettings(c))
+					return nil
+
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package pdao
+
+import (
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
+)
+
+func getSettings(c *cli.Context) (*api.PDAOSettingsResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOSettingsResponse{
+		Settings: make([]api.PDAOSettingValue, len(rputils.PDAOSettings)),
+	}
+
+	// Get the setting values
+	var wg errgroup.Group
+	for i, setting := range rputils.PDAOSettings {
+		i, setting := i, setting
+		wg.Go(func() error {
+			value, err := getSettingValue(rp, setting, nil)
+			if err == nil {
+				response.Settings[i] = api.PDAOSettingValue{
+					Name:  setting.Name,
+					Path:  setting.Path,
+					Value: value,
+				}
+			}
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,34 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
+)
+
+// Get the current value of a protocol DAO setting
+func getSettingValue(rp *rocketpool.RocketPool, setting rputils.DAOSetting, opts *bind.CallOpts) (*big.Int, error) {
+	settingsContract, err := rp.GetContract(setting.ContractName, opts)
+	if err != nil {
+		return nil, err
+	}
+	if setting.Type == rputils.DAOSettingType_Bool {
+		value := new(bool)
+		if err := settingsContract.Call(opts, value, "getSettingBool", setting.Path); err != nil {
+			return nil, fmt.Errorf("Could not get setting %s: %w", setting.Path, err)
+		}
+		if *value {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	}
+	value := new(*big.Int)
+	if err := settingsContract.Call(opts, value, "getSettingUint", setting.Path); err != nil {
+		return nil, fmt.Errorf("Could not get setting %s: %w", setting.Path, err)
+	}
+	return *value, nil
+}
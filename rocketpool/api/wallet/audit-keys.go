@@ -0,0 +1,255 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Seb369888/poolsea-go/minipool"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	"github.com/Seb369888/smartnode/shared/types/api"
+	cfgtypes "github.com/Seb369888/smartnode/shared/types/config"
+	walletutils "github.com/Seb369888/smartnode/shared/utils/wallet"
+)
+
+func auditValidatorKeys(c *cli.Context) (*api.AuditValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.AuditValidatorKeysResponse{}
+
+	// Audit the keys
+	response.ValidatorClient = getValidatorClientKeystore(cfg)
+	response.Keys, _, response.SkippedCustomKeyFiles, err = getValidatorKeyAudits(cfg, w, rp, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func repairValidatorKey(c *cli.Context, minipoolAddress common.Address) (*api.RepairValidatorKeyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.RepairValidatorKeyResponse{}
+
+	// Audit the minipool's key
+	audits, derivedKeys, _, err := getValidatorKeyAudits(cfg, w, rp, bc, &minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(audits) == 0 {
+		return nil, fmt.Errorf("Minipool %s is not owned by this node or does not have a validator key yet", minipoolAddress.Hex())
+	}
+	audit := audits[0]
+	response.Issue = audit.Issue
+
+	// Repair it
+	switch audit.Issue {
+	case api.ValidatorKeyIssue_None:
+		return nil, fmt.Errorf("The validator key for minipool %s does not need to be repaired", minipoolAddress.Hex())
+
+	case api.ValidatorKeyIssue_LoadedButExited:
+		if err := w.DeleteValidatorKey(audit.Pubkey); err != nil {
+			return nil, err
+		}
+
+	case api.ValidatorKeyIssue_MissingLocally, api.ValidatorKeyIssue_NotInValidatorClient:
+		if audit.IsCustomKey {
+			return nil, fmt.Errorf("The validator key for minipool %s is a custom key; please use `rocketpool wallet rebuild` to restore it", minipoolAddress.Hex())
+		}
+		validatorKey, exists := derivedKeys[audit.Pubkey]
+		if !exists {
+			return nil, fmt.Errorf("The validator key for minipool %s could not be derived from the node wallet", minipoolAddress.Hex())
+		}
+		if err := w.SaveValidatorKey(validatorKey); err != nil {
+			return nil, err
+		}
+		if err := w.Save(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Audit the validator keys for the node's minipools, optionally restricted to a single minipool.
+// Also returns the files in the custom key folder that aren't keystores.
+func getValidatorKeyAudits(cfg *config.RocketPoolConfig, w *wallet.Wallet, rp *rocketpool.RocketPool, bc beacon.Client, minipoolAddress *common.Address) ([]api.ValidatorKeyAudit, map[types.ValidatorPubkey]wallet.ValidatorKey, []string, error) {
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	minipools, err := minipool.GetNodeMinipools(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Get the ones that have a validator key
+	zeroPubkey := types.ValidatorPubkey{}
+	audits := []api.ValidatorKeyAudit{}
+	pubkeys := []types.ValidatorPubkey{}
+	for _, mpDetails := range minipools {
+		if minipoolAddress != nil && mpDetails.Address != *minipoolAddress {
+			continue
+		}
+		if bytes.Equal(mpDetails.Pubkey[:], zeroPubkey[:]) {
+			continue
+		}
+		audits = append(audits, api.ValidatorKeyAudit{
+			MinipoolAddress: mpDetails.Address,
+			Pubkey:          mpDetails.Pubkey,
+		})
+		pubkeys = append(pubkeys, mpDetails.Pubkey)
+	}
+	if len(audits) == 0 {
+		return audits, nil, nil, nil
+	}
+
+	// Find where each key came from
+	derivedKeys, err := walletutils.FindValidatorKeys(w, pubkeys)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error deriving validator keys: %w", err)
+	}
+	customPubkeys, skippedFiles, err := walletutils.GetCustomKeyPubkeys(cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Get the validator statuses
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting validator statuses: %w", err)
+	}
+
+	validatorClient := getValidatorClientKeystore(cfg)
+	for i := range audits {
+		audit := &audits[i]
+
+		// Get the key's origin
+		if validatorKey, exists := derivedKeys[audit.Pubkey]; exists {
+			audit.IsDerived = true
+			audit.WalletIndex = validatorKey.WalletIndex
+			audit.DerivationPath = validatorKey.DerivationPath
+		}
+		audit.IsCustomKey = customPubkeys[audit.Pubkey]
+
+		// Check if the minipool is done validating
+		mp, err := minipool.NewMinipool(rp, audit.MinipoolAddress, nil)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		finalised, err := mp.GetFinalised(nil)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error checking if minipool %s is finalised: %w", audit.MinipoolAddress.Hex(), err)
+		}
+		audit.Exited = finalised || isValidatorExited(statuses[audit.Pubkey])
+
+		// Check where the key is loaded
+		audit.Keystores, err = w.GetValidatorKeyKeystores(audit.Pubkey)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, name := range audit.Keystores {
+			if name == validatorClient {
+				audit.InValidatorClient = true
+				break
+			}
+		}
+
+		// Flag any issues
+		if audit.Exited {
+			if len(audit.Keystores) > 0 {
+				audit.Issue = api.ValidatorKeyIssue_LoadedButExited
+			}
+		} else if len(audit.Keystores) == 0 {
+			audit.Issue = api.ValidatorKeyIssue_MissingLocally
+		} else if !audit.InValidatorClient {
+			audit.Issue = api.ValidatorKeyIssue_NotInValidatorClient
+		}
+	}
+
+	return audits, derivedKeys, skippedFiles, nil
+
+}
+
+// Check if a validator has left the Beacon Chain
+func isValidatorExited(status beacon.ValidatorStatus) bool {
+	switch status.Status {
+	case beacon.ValidatorState_ExitedUnslashed,
+		beacon.ValidatorState_ExitedSlashed,
+		beacon.ValidatorState_WithdrawalPossible,
+		beacon.ValidatorState_WithdrawalDone:
+		return true
+	}
+	return false
+}
+
+// Get the name of the wallet keystore used by the configured Validator Client
+func getValidatorClientKeystore(cfg *config.RocketPoolConfig) string {
+	if cfg.IsNativeMode {
+		return string(cfg.Native.ConsensusClient.Value.(cfgtypes.ConsensusClient))
+	}
+	cc, _ := cfg.GetSelectedConsensusClient()
+	return string(cc)
+}
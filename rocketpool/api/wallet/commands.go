@@ -57,6 +57,29 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Provide the password for an existing node wallet to the configured password storage",
+				UsageText: "poolsea api wallet unlock password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					password, err := cliutils.ValidateNodePassword("wallet password", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(unlockWallet(c, password))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "init",
 				Aliases:   []string{"i"},
@@ -172,6 +195,48 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "audit-keys",
+				Aliases:   []string{"ak"},
+				Usage:     "Audit the validator keys of the node's minipools",
+				UsageText: "poolsea api wallet audit-keys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(auditValidatorKeys(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "repair-key",
+				Aliases:   []string{"rk"},
+				Usage:     "Repair the issue found by a key audit for a minipool's validator key",
+				UsageText: "poolsea api wallet repair-key minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(repairValidatorKey(c, minipoolAddress))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "test-recovery",
 				Aliases:   []string{"r"},
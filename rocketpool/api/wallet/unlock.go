@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+func unlockWallet(c *cli.Context, password string) (*api.UnlockWalletResponse, error) {
+
+	// Get services
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UnlockWalletResponse{}
+
+	// Check if password is already set
+	if pm.IsPasswordSet() {
+		return nil, errors.New("The node password is already set")
+	}
+
+	// Set password
+	if err := pm.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	// Make sure it decrypts the wallet, and forget it if it doesn't
+	w, err := services.GetWallet(c)
+	if err != nil {
+		if deleteErr := pm.DeletePassword(); deleteErr != nil {
+			return nil, fmt.Errorf("The password could not decrypt the node wallet (%s), and removing it failed: %w", err.Error(), deleteErr)
+		}
+		return nil, fmt.Errorf("The password could not decrypt the node wallet: %w", err)
+	}
+	if !w.IsInitialized() {
+		if err := pm.DeletePassword(); err != nil {
+			return nil, fmt.Errorf("error removing password: %w", err)
+		}
+		return nil, errors.New("The node wallet has not been initialized yet")
+	}
+
+	// Return response
+	return &response, nil
+
+}
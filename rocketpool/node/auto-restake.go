@@ -0,0 +1,358 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/rewards"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/notify"
+	rprewards "github.com/Seb369888/smartnode/shared/services/rewards"
+	"github.com/Seb369888/smartnode/shared/services/state"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	cfgtypes "github.com/Seb369888/smartnode/shared/types/config"
+	"github.com/Seb369888/smartnode/shared/utils/api"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Auto-restake task
+type autoRestake struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	notifier       *notify.Notifier
+	gasPolicy      rpgas.GasPolicy
+	policyTracker  *rpgas.GasPolicyTracker
+	mode           cfgtypes.AutoRestakeMode
+	targetRatio    float64
+	gasMultiplier  float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+	intervalCache  map[uint64]rprewards.IntervalInfo
+	lastReport     string
+}
+
+// The rewards that can be claimed and what to do with them
+type restakePlan struct {
+	indices      []*big.Int
+	amountRPL    []*big.Int
+	amountETH    []*big.Int
+	merkleProofs [][]common.Hash
+	totalRPL     *big.Int
+	totalETH     *big.Int
+	totalValue   *big.Int
+	restakeRPL   *big.Int
+}
+
+// Create auto-restake task
+func newAutoRestake(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*autoRestake, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("auto-restake", cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if auto-restaking can send transactions
+	mode := cfg.Smartnode.AutoRestakeMode.Value.(cfgtypes.AutoRestakeMode)
+	if mode == cfgtypes.AutoRestakeMode_Enabled && gasPolicy.IdealGwei == 0 {
+		logger.Println("Automatic tx gas threshold is 0, auto-restake will only run in dry-run mode.")
+		mode = cfgtypes.AutoRestakeMode_DryRun
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested max fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &autoRestake{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		notifier:       notifier,
+		gasPolicy:      gasPolicy,
+		policyTracker:  policyTracker,
+		mode:           mode,
+		targetRatio:    cfg.Smartnode.AutoRestakeTargetRatio.Value.(float64),
+		gasMultiplier:  cfg.Smartnode.AutoRestakeGasMultiplier.Value.(float64),
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		gasLimit:       0,
+		intervalCache:  map[uint64]rprewards.IntervalInfo{},
+	}, nil
+
+}
+
+// Claim and restake rewards
+func (t *autoRestake) run(state *state.NetworkState) error {
+
+	// Check if auto-restake is disabled
+	if t.mode == cfgtypes.AutoRestakeMode_Disabled {
+		return nil
+	}
+
+	// Check if Atlas has been deployed yet
+	if !state.IsAtlasDeployed {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for unclaimed rewards...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	node, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists {
+		return nil
+	}
+
+	// Get the claimable rewards
+	plan, err := t.getRestakePlan(nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+	if plan == nil {
+		return nil
+	}
+
+	// Work out how much RPL to restake to reach the target ratio
+	plan.restakeRPL = t.getRestakeAmount(node.RplStake, node.EthMatched, state.NetworkDetails.RplPrice, plan.totalRPL)
+	plan.totalValue = new(big.Int).Mul(plan.totalRPL, state.NetworkDetails.RplPrice)
+	plan.totalValue.Div(plan.totalValue, eth.EthToWei(1))
+	plan.totalValue.Add(plan.totalValue, plan.totalETH)
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	if plan.restakeRPL.Sign() > 0 {
+		gasInfo, err = rewards.EstimateClaimAndStakeGas(t.rp, nodeAccount.Address, plan.indices, plan.amountRPL, plan.amountETH, plan.merkleProofs, plan.restakeRPL, opts)
+	} else {
+		gasInfo, err = rewards.EstimateClaimGas(t.rp, nodeAccount.Address, plan.indices, plan.amountRPL, plan.amountETH, plan.merkleProofs, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to claim rewards: %w", err)
+	}
+	gas := gasInfo.SafeGasLimit
+	if t.gasLimit != 0 {
+		gas = t.gasLimit
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check that the rewards are worth the gas
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gas), maxFee)
+	threshold := eth.EthToWei(eth.WeiToEth(gasCost) * t.gasMultiplier)
+	if plan.totalValue.Cmp(threshold) < 0 {
+		t.log.Printlnf("Unclaimed rewards from %d interval(s) are worth %.6f ETH, which is less than %.2f times the %.6f ETH it would cost to claim them; waiting for more rewards.", len(plan.indices), eth.WeiToEth(plan.totalValue), t.gasMultiplier, eth.WeiToEth(gasCost))
+		return nil
+	}
+
+	// Only report the same rewards once in the modes that don't send anything
+	report := t.getReport(plan)
+	if t.mode != cfgtypes.AutoRestakeMode_Enabled && report == t.lastReport {
+		return nil
+	}
+	t.lastReport = report
+
+	switch t.mode {
+	case cfgtypes.AutoRestakeMode_Notify:
+		t.log.Printlnf("Rewards from %d interval(s) are ready to claim: %.6f RPL and %.6f ETH (worth %.6f ETH in total).", len(plan.indices), eth.WeiToEth(plan.totalRPL), eth.WeiToEth(plan.totalETH), eth.WeiToEth(plan.totalValue))
+		t.notifier.Notify(notify.AutoRestakeNeeded(len(plan.indices), eth.WeiToEth(plan.totalRPL), eth.WeiToEth(plan.totalETH), eth.WeiToEth(plan.totalValue)))
+		return nil
+
+	case cfgtypes.AutoRestakeMode_DryRun:
+		t.log.Println("Dry run, nothing will be sent:")
+		t.log.Println(report)
+		api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
+		return nil
+	}
+
+	// Check the max fee against the gas policy; claiming is optional, so there is no deadline
+	decision := t.gasPolicy.Evaluate(maxFee, time.Time{}, time.Time{})
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
+		return nil
+	}
+	t.log.Println(report)
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = t.maxPriorityFee
+	opts.GasLimit = gas
+
+	// Claim the rewards
+	var hash common.Hash
+	if plan.restakeRPL.Sign() > 0 {
+		hash, err = rewards.ClaimAndStake(t.rp, nodeAccount.Address, plan.indices, plan.amountRPL, plan.amountETH, plan.merkleProofs, plan.restakeRPL, opts)
+	} else {
+		hash, err = rewards.Claim(t.rp, nodeAccount.Address, plan.indices, plan.amountRPL, plan.amountETH, plan.merkleProofs, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("Could not claim rewards: %w", err)
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Printlnf("Successfully claimed rewards from %d interval(s).", len(plan.indices))
+	return nil
+
+}
+
+// Get the rewards from the intervals that can be claimed, or nil if there aren't any
+func (t *autoRestake) getRestakePlan(nodeAddress common.Address) (*restakePlan, error) {
+
+	// Get the unclaimed intervals
+	unclaimed, _, err := rprewards.GetClaimStatus(t.rp, nodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error getting claim status: %w", err)
+	}
+
+	plan := &restakePlan{
+		indices:      []*big.Int{},
+		amountRPL:    []*big.Int{},
+		amountETH:    []*big.Int{},
+		merkleProofs: [][]common.Hash{},
+		totalRPL:     big.NewInt(0),
+		totalETH:     big.NewInt(0),
+	}
+	for _, interval := range unclaimed {
+
+		// Get the interval info; it doesn't change once the tree file is valid, so it's cached
+		intervalInfo, exists := t.intervalCache[interval]
+		if !exists {
+			intervalInfo, err = rprewards.GetIntervalInfo(t.rp, t.cfg, nodeAddress, interval)
+			if err != nil {
+				return nil, fmt.Errorf("error getting info for interval %d: %w", interval, err)
+			}
+			if !intervalInfo.TreeFileExists || !intervalInfo.MerkleRootValid {
+				t.log.Printlnf("The rewards tree for interval %d isn't available yet, so it can't be claimed.", interval)
+				continue
+			}
+			t.intervalCache[interval] = intervalInfo
+		}
+		if !intervalInfo.NodeExists {
+			continue
+		}
+
+		// Add its rewards
+		rplForInterval := big.NewInt(0)
+		rplForInterval.Add(rplForInterval, &intervalInfo.CollateralRplAmount.Int)
+		rplForInterval.Add(rplForInterval, &intervalInfo.ODaoRplAmount.Int)
+		ethForInterval := big.NewInt(0)
+		ethForInterval.Add(ethForInterval, &intervalInfo.SmoothingPoolEthAmount.Int)
+
+		plan.indices = append(plan.indices, big.NewInt(0).SetUint64(interval))
+		plan.amountRPL = append(plan.amountRPL, rplForInterval)
+		plan.amountETH = append(plan.amountETH, ethForInterval)
+		plan.merkleProofs = append(plan.merkleProofs, intervalInfo.MerkleProof)
+		plan.totalRPL.Add(plan.totalRPL, rplForInterval)
+		plan.totalETH.Add(plan.totalETH, ethForInterval)
+	}
+
+	if len(plan.indices) == 0 {
+		return nil, nil
+	}
+	return plan, nil
+
+}
+
+// Get the amount of the claimed RPL to restake so the RPL stake reaches the target ratio of the borrowed ETH
+func (t *autoRestake) getRestakeAmount(rplStake *big.Int, borrowedEth *big.Int, rplPrice *big.Int, claimedRpl *big.Int) *big.Int {
+	if t.targetRatio <= 0 || borrowedEth.Sign() == 0 || rplPrice.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	// Target stake = borrowed ETH * ratio / RPL price
+	targetStake := new(big.Int).Mul(borrowedEth, big.NewInt(int64(t.targetRatio*100)))
+	targetStake.Div(targetStake, big.NewInt(10000))
+	targetStake.Mul(targetStake, eth.EthToWei(1))
+	targetStake.Div(targetStake, rplPrice)
+
+	restake := new(big.Int).Sub(targetStake, rplStake)
+	if restake.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	if restake.Cmp(claimedRpl) > 0 {
+		return new(big.Int).Set(claimedRpl)
+	}
+	return restake
+}
+
+// Describe what claiming the rewards would do
+func (t *autoRestake) getReport(plan *restakePlan) string {
+	intervals := make([]string, len(plan.indices))
+	for i, index := range plan.indices {
+		intervals[i] = index.String()
+	}
+	remainingRpl := new(big.Int).Sub(plan.totalRPL, plan.restakeRPL)
+	return fmt.Sprintf("Claiming rewards from interval(s) %s: restaking %.6f RPL to reach the %.2f%% target ratio, and sending %.6f RPL and %.6f ETH to the withdrawal address.",
+		strings.Join(intervals, ", "), eth.WeiToEth(plan.restakeRPL), t.targetRatio, eth.WeiToEth(remainingRpl), eth.WeiToEth(plan.totalETH))
+}
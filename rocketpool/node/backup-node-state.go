@@ -0,0 +1,133 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/backup"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Backup node state task
+type backupNodeState struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+}
+
+// Create backup node state task
+func newBackupNodeState(c *cli.Context, logger log.ColorLogger) (*backupNodeState, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &backupNodeState{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+	}, nil
+
+}
+
+// Create a new backup if the last one is older than the configured interval
+func (t *backupNodeState) run() error {
+
+	// Check if automatic backups are enabled
+	intervalHours := t.cfg.Smartnode.AutoBackupInterval.Value.(uint64)
+	if intervalHours == 0 {
+		return nil
+	}
+	interval := time.Duration(intervalHours) * time.Hour
+
+	// Check the latest backup
+	backupFolder := os.ExpandEnv(t.cfg.Smartnode.GetAutoBackupFolder(true))
+	backups, err := backup.GetBackups(backupFolder)
+	if err != nil {
+		return err
+	}
+	if len(backups) > 0 {
+		info, err := os.Stat(backups[len(backups)-1])
+		if err != nil {
+			return fmt.Errorf("error checking latest backup: %w", err)
+		}
+		if time.Since(info.ModTime()) < interval {
+			return nil
+		}
+	}
+
+	// Automatic backups are encrypted with their own passphrase, so they don't depend on how the wallet password is stored
+	passphrasePath := t.cfg.Smartnode.GetBackupPassphrasePath(true)
+	passphraseBytes, err := os.ReadFile(passphrasePath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.log.Println("Automatic backups are enabled but no backup passphrase has been set, skipping. Please set one with `rocketpool service set-backup-passphrase`.")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading backup passphrase from [%s]: %w", passphrasePath, err)
+	}
+	passphrase := strings.TrimSpace(string(passphraseBytes))
+
+	// Log
+	t.log.Println("Creating automatic backup of the node state...")
+
+	// Create the backup
+	settingsPath := os.ExpandEnv(t.c.GlobalString("settings"))
+	dataPath := os.ExpandEnv(t.cfg.Smartnode.GetDataFolder(true))
+	err = os.MkdirAll(backupFolder, backup.DefaultDirectoryMode)
+	if err != nil {
+		return fmt.Errorf("error creating backup folder [%s]: %w", backupFolder, err)
+	}
+	backupPath := filepath.Join(backupFolder, backup.GetFilename(time.Now()))
+	entries, err := backup.SaveBackup(backupPath, settingsPath, dataPath, passphrase)
+	if err != nil {
+		return fmt.Errorf("error saving automatic backup to [%s]: %w", backupPath, err)
+	}
+
+	// The daemon runs as root in Docker mode, so hand the backup to whoever owns the folder on the host
+	err = matchFolderOwner(backupPath, backupFolder)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't set the owner of %s: %s", backupPath, err.Error())
+	}
+	t.log.Printlnf("Backed up %d files to %s.", len(entries), backupPath)
+
+	// Remove old backups
+	pruned, err := backup.PruneBackups(backupFolder, t.cfg.Smartnode.AutoBackupRetention.Value.(uint64))
+	for _, prunedBackup := range pruned {
+		t.log.Printlnf("Removed old backup %s.", prunedBackup)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// Give a file the same owner and group as the folder it's in
+func matchFolderOwner(path string, folder string) error {
+	info, err := os.Stat(folder)
+	if err != nil {
+		return err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	if int(stat.Uid) == os.Getuid() && int(stat.Gid) == os.Getgid() {
+		return nil
+	}
+	return os.Chown(path, int(stat.Uid), int(stat.Gid))
+}
@@ -0,0 +1,452 @@
+package node
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/auction"
+	"github.com/Seb369888/poolsea-go/network"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/settings/protocol"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	auctionapi "github.com/Seb369888/smartnode/rocketpool/api/auction"
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	"github.com/Seb369888/smartnode/shared/utils/api"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Auction ledger actions
+const (
+	auctionActionBid     string = "bid"
+	auctionActionClaim   string = "claim"
+	auctionActionRecover string = "recover"
+)
+
+// Auction ledger transaction statuses
+const (
+	auctionStatusSuccess string = "success"
+	auctionStatusFailed  string = "failed"
+	auctionStatusPending string = "pending"
+)
+
+// An entry in the auction ledger
+type auctionLedgerEntry struct {
+	Time     time.Time   `json:"time"`
+	Action   string      `json:"action"`
+	Lot      uint64      `json:"lot"`
+	Amount   *big.Int    `json:"amount,omitempty"`
+	LotPrice *big.Int    `json:"lotPrice,omitempty"`
+	RplPrice *big.Int    `json:"rplPrice,omitempty"`
+	TxHash   common.Hash `json:"txHash"`
+	Status   string      `json:"status"`
+}
+
+// Bid on auction lots task
+type bidAuctionLots struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	ledgerPath     string
+	disabled       bool
+	minDiscount    float64
+	budget         *big.Int
+	gasPolicy      rpgas.GasPolicy
+	policyTracker  *rpgas.GasPolicyTracker
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+}
+
+// Create bid on auction lots task
+func newBidAuctionLots(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*bidAuctionLots, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("bid", cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if automatic bidding is disabled
+	disabled := !cfg.Smartnode.AutoBidEnabled.Value.(bool)
+	if !disabled && gasPolicy.IdealGwei == 0 {
+		logger.Println("Automatic tx gas threshold is 0, disabling automatic auction bidding.")
+		disabled = true
+	}
+
+	// Safety clamp
+	minDiscount := cfg.Smartnode.AutoBidMinDiscount.Value.(float64)
+	if minDiscount < 0 {
+		logger.Printlnf("WARNING: Automatic bid minimum discount is negative (%.2f%%), using 0%% instead.", minDiscount)
+		minDiscount = 0
+	} else if minDiscount >= 100 {
+		logger.Printlnf("WARNING: Automatic bid minimum discount is %.2f%%, no lot can ever be bid on.", minDiscount)
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &bidAuctionLots{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		ledgerPath:     os.ExpandEnv(cfg.Smartnode.GetAuctionLedgerPath(true)),
+		disabled:       disabled,
+		minDiscount:    minDiscount,
+		budget:         eth.EthToWei(cfg.Smartnode.AutoBidBudget.Value.(float64)),
+		gasPolicy:      gasPolicy,
+		policyTracker:  policyTracker,
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+	}, nil
+
+}
+
+// Bid on discounted lots, claim won lots and recover unclaimed RPL
+func (t *bidAuctionLots) run() error {
+
+	// Check if automatic bidding is disabled
+	if t.disabled {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for RPL auction lots...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the lots
+	lots, err := auctionapi.GetAllLotDetails(t.rp, nodeAccount.Address)
+	if err != nil {
+		return fmt.Errorf("error getting auction lots: %w", err)
+	}
+	if len(lots) == 0 {
+		return nil
+	}
+
+	// Claim RPL from won lots and recover unclaimed RPL from lots the node bid on that ended without selling out
+	for _, lot := range lots {
+		if lot.Details.AddressBidAmount.Sign() == 0 {
+			continue
+		}
+		if lot.ClaimAvailable {
+			if _, err := t.submit(auctionActionClaim, lot.Details, nil, nil); err != nil {
+				t.log.Println(fmt.Errorf("error claiming RPL from lot %d: %w", lot.Details.Index, err))
+			}
+		}
+		if lot.RPLRecoveryAvailable {
+			if _, err := t.submit(auctionActionRecover, lot.Details, nil, nil); err != nil {
+				t.log.Println(fmt.Errorf("error recovering unclaimed RPL from lot %d: %w", lot.Details.Index, err))
+			}
+		}
+	}
+
+	// Check if there is anything to bid on
+	biddable := []auction.LotDetails{}
+	for _, lot := range lots {
+		if lot.BiddingAvailable {
+			biddable = append(biddable, lot.Details)
+		}
+	}
+	if len(biddable) == 0 {
+		return nil
+	}
+
+	// Get the remaining budget
+	spent, err := t.getAmountSpent()
+	if err != nil {
+		return err
+	}
+	remainingBudget := big.NewInt(0).Sub(t.budget, spent)
+	if remainingBudget.Sign() <= 0 {
+		return nil
+	}
+
+	// Check if bidding is enabled
+	bidOnLotEnabled, err := protocol.GetBidOnLotEnabled(t.rp, nil)
+	if err != nil {
+		return err
+	}
+	if !bidOnLotEnabled {
+		return nil
+	}
+
+	// Get the current block and the highest price the node is willing to pay
+	currentBlock, err := t.rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return fmt.Errorf("error getting latest block number: %w", err)
+	}
+	rplPrice, err := network.GetRPLPrice(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("error getting RPL price: %w", err)
+	}
+	maxLotPrice := t.getMaxLotPrice(rplPrice)
+
+	// Bid on the lots that are cheap enough
+	for _, lot := range biddable {
+		if currentBlock >= lot.EndBlock || lot.CurrentPrice.Cmp(maxLotPrice) > 0 {
+			continue
+		}
+
+		// Bid for the rest of the lot, within the remaining budget
+		amount := big.NewInt(0).Mul(lot.RemainingRPLAmount, lot.CurrentPrice)
+		amount.Quo(amount, eth.EthToWei(1))
+		if amount.Cmp(remainingBudget) > 0 {
+			amount.Set(remainingBudget)
+		}
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		status, err := t.submit(auctionActionBid, lot, amount, rplPrice)
+		if err != nil {
+			t.log.Println(fmt.Errorf("error bidding on lot %d: %w", lot.Index, err))
+		}
+		if status == "" || status == auctionStatusFailed {
+			continue
+		}
+		remainingBudget.Sub(remainingBudget, amount)
+		if remainingBudget.Sign() <= 0 {
+			t.log.Println("The automatic bidding budget has been spent.")
+			break
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Get the highest lot price the node will bid at, given the oracle RPL price
+func (t *bidAuctionLots) getMaxLotPrice(rplPrice *big.Int) *big.Int {
+	basisPoints := int64((100 - t.minDiscount) * 100)
+	if basisPoints < 0 {
+		basisPoints = 0
+	}
+	maxLotPrice := big.NewInt(0).Mul(rplPrice, big.NewInt(basisPoints))
+	return maxLotPrice.Quo(maxLotPrice, big.NewInt(10000))
+}
+
+// Submit a bid, claim or recovery transaction and record it in the ledger once it has been mined; returns the ledger status, or an empty string if nothing was sent
+func (t *bidAuctionLots) submit(action string, lot auction.LotDetails, amount *big.Int, rplPrice *big.Int) (string, error) {
+
+	// Log
+	switch action {
+	case auctionActionBid:
+		t.log.Printlnf("Bidding %.6f ETH on lot %d at %.6f ETH per RPL (oracle price %.6f ETH)...", eth.WeiToEth(amount), lot.Index, eth.WeiToEth(lot.CurrentPrice), eth.WeiToEth(rplPrice))
+	case auctionActionClaim:
+		t.log.Printlnf("Claiming RPL from lot %d...", lot.Index)
+	case auctionActionRecover:
+		t.log.Printlnf("Recovering unclaimed RPL from lot %d...", lot.Index)
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return "", err
+	}
+	opts.Value = amount
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	switch action {
+	case auctionActionBid:
+		gasInfo, err = auction.EstimatePlaceBidGas(t.rp, lot.Index, opts)
+	case auctionActionClaim:
+		gasInfo, err = auction.EstimateClaimBidGas(t.rp, lot.Index, opts)
+	case auctionActionRecover:
+		gasInfo, err = auction.EstimateRecoverUnclaimedRPLGas(t.rp, lot.Index, opts)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Could not estimate the gas required to %s: %w", action, err)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Check the max fee against the gas policy; auction transactions are optional, so there is no deadline
+	decision := t.gasPolicy.Evaluate(maxFee, time.Time{}, time.Time{})
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
+		return "", nil
+	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, 0)
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = t.maxPriorityFee
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	// Submit
+	var hash common.Hash
+	switch action {
+	case auctionActionBid:
+		hash, err = auction.PlaceBid(t.rp, lot.Index, opts)
+	case auctionActionClaim:
+		hash, err = auction.ClaimBid(t.rp, lot.Index, opts)
+	case auctionActionRecover:
+		hash, err = auction.RecoverUnclaimedRPL(t.rp, lot.Index, opts)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	status := auctionStatusPending
+	receipt, waitErr := api.PrintAndWaitForTransactionReceipt(t.cfg, hash, t.rp.Client, t.log)
+	if waitErr == nil {
+		status = getAuctionStatus(receipt)
+	}
+
+	// Record the result; pending transactions are resolved from their receipt when the budget is next checked
+	entry := auctionLedgerEntry{
+		Time:     time.Now().UTC(),
+		Action:   action,
+		Lot:      lot.Index,
+		Amount:   amount,
+		LotPrice: lot.CurrentPrice,
+		RplPrice: rplPrice,
+		TxHash:   hash,
+		Status:   status,
+	}
+	if err := t.appendToLedger(entry); err != nil {
+		t.log.Println(fmt.Errorf("error recording %s on lot %d in the auction ledger: %w", action, lot.Index, err))
+	}
+	if waitErr != nil {
+		return status, waitErr
+	}
+	if status == auctionStatusFailed {
+		return status, fmt.Errorf("transaction %s failed", hash.Hex())
+	}
+	return status, nil
+
+}
+
+// Get the ledger status of a mined transaction
+func getAuctionStatus(receipt *types.Receipt) string {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return auctionStatusSuccess
+	}
+	return auctionStatusFailed
+}
+
+// Get the total amount of ETH successfully bid so far, according to the ledger
+func (t *bidAuctionLots) getAmountSpent() (*big.Int, error) {
+	spent := big.NewInt(0)
+	file, err := os.Open(t.ledgerPath)
+	if os.IsNotExist(err) {
+		return spent, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening auction ledger: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry auctionLedgerEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("error parsing line %d of the auction ledger: %w", line, err)
+		}
+		if entry.Action != auctionActionBid || entry.Amount == nil {
+			continue
+		}
+
+		// Resolve bids that were still pending when they were recorded; ones that can't be found yet were dropped or are still waiting, so they don't count
+		status := entry.Status
+		if status == auctionStatusPending {
+			receipt, err := t.rp.Client.TransactionReceipt(context.Background(), entry.TxHash)
+			if err == nil {
+				status = getAuctionStatus(receipt)
+			} else if !errors.Is(err, ethereum.NotFound) {
+				return nil, fmt.Errorf("error getting the receipt for bid %s: %w", entry.TxHash.Hex(), err)
+			}
+		}
+		if status == auctionStatusSuccess {
+			spent.Add(spent, entry.Amount)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading auction ledger: %w", err)
+	}
+	return spent, nil
+}
+
+// Append an entry to the ledger
+func (t *bidAuctionLots) appendToLedger(entry auctionLedgerEntry) error {
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.ledgerPath), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(t.ledgerPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(bytes, '\n'))
+	return err
+}
@@ -0,0 +1,141 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/alerting"
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/services/state"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Check alerts task
+type checkAlerts struct {
+	c         *cli.Context
+	log       log.ColorLogger
+	cfg       *config.RocketPoolConfig
+	w         *wallet.Wallet
+	rp        *rocketpool.RocketPool
+	bc        beacon.Client
+	evaluator *alerting.Evaluator
+}
+
+// Create check alerts task
+func newCheckAlerts(c *cli.Context, logger log.ColorLogger) (*checkAlerts, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	t := &checkAlerts{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		rp:  rp,
+		bc:  bc,
+	}
+	if !cfg.Smartnode.AlertsEnabled.Value.(bool) {
+		return t, nil
+	}
+
+	// Set up the sinks
+	sinks := []alerting.Sink{}
+	if notifier != nil {
+		sinks = append(sinks, alerting.NewNotifierSink(notifier))
+	}
+	if address := cfg.Smartnode.AlertSmtpAddress.Value.(string); address != "" {
+		to := []string{}
+		for _, recipient := range strings.Split(cfg.Smartnode.AlertSmtpTo.Value.(string), ",") {
+			if recipient = strings.TrimSpace(recipient); recipient != "" {
+				to = append(to, recipient)
+			}
+		}
+		from := cfg.Smartnode.AlertSmtpFrom.Value.(string)
+		if from == "" || len(to) == 0 {
+			return nil, fmt.Errorf("the alert SMTP relay is set, but the alert email sender or recipients are missing")
+		}
+		sinks = append(sinks, alerting.NewSmtpSink(address, from, to))
+	}
+	if cfg.Smartnode.AlertLogToFile.Value.(bool) {
+		sinks = append(sinks, alerting.NewFileSink(cfg.Smartnode.GetAlertLogPath()))
+	}
+
+	// Create the evaluator
+	rules := alerting.NewDefaultRules(cfg.Smartnode.AlertOfflineEpochs.Value.(uint64))
+	t.evaluator, err = alerting.NewEvaluator(rules, sinks, cfg.Smartnode.GetAlertStatePath())
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+
+}
+
+// Check alerts
+func (t *checkAlerts) run(state *state.NetworkState) error {
+
+	// Check if alerts are enabled
+	if t.evaluator == nil {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Evaluate the rules
+	changes, err := t.evaluator.Run(&alerting.RuleContext{
+		Cfg:         t.cfg,
+		Rp:          t.rp,
+		Bc:          t.bc,
+		NodeAddress: nodeAccount.Address,
+		State:       state,
+		Time:        lifecycle.GetStateTime(state),
+	})
+
+	// Log the alerts that started or stopped, even if some rules or sinks failed
+	for _, alert := range changes {
+		switch {
+		case alert.Status == alerting.AlertStatus_Resolved:
+			t.log.Printlnf("Resolved alert: %s", alert.Summary)
+		case alert.Severity == alerting.Severity_Critical:
+			t.log.Printlnf("CRITICAL alert: %s", alert.Summary)
+		default:
+			t.log.Printlnf("Warning alert: %s", alert.Summary)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error checking alerts: %w", err)
+	}
+	return nil
+
+}
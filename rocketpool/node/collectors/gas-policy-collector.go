@@ -0,0 +1,103 @@
+package collectors
+
+import (
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Seb369888/smartnode/shared/services/gas"
+)
+
+// Represents the collector for the gas policies of the automatic transactions
+type GasPolicyCollector struct {
+	// The max fee each task will always accept
+	idealMaxFee *prometheus.Desc
+
+	// The max fee each task will never go above, or 0 if there is no ceiling
+	ceilingMaxFee *prometheus.Desc
+
+	// The max fee each task accepted at its last check, or +Inf if it accepted any max fee
+	acceptableMaxFee *prometheus.Desc
+
+	// The suggested max fee at each task's last check
+	suggestedMaxFee *prometheus.Desc
+
+	// The time until the deadline of each task's last check
+	timeUntilDeadline *prometheus.Desc
+
+	// Whether each task is waiting for the max fee to drop
+	waiting *prometheus.Desc
+
+	// The latest gas policy decisions
+	policyTracker *gas.GasPolicyTracker
+}
+
+// Create a new GasPolicyCollector instance
+func NewGasPolicyCollector(policyTracker *gas.GasPolicyTracker) *GasPolicyCollector {
+	subsystem := "gas_policy"
+	return &GasPolicyCollector{
+		idealMaxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "ideal_max_fee_gwei"),
+			"The max fee each automatic task will always accept, in gwei",
+			[]string{"task"}, nil,
+		),
+		ceilingMaxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "ceiling_max_fee_gwei"),
+			"The max fee each automatic task will never go above, in gwei (0 if there is no ceiling)",
+			[]string{"task"}, nil,
+		),
+		acceptableMaxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "acceptable_max_fee_gwei"),
+			"The max fee each automatic task accepted at its last check, in gwei",
+			[]string{"task"}, nil,
+		),
+		suggestedMaxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "suggested_max_fee_gwei"),
+			"The suggested max fee at each automatic task's last check, in gwei",
+			[]string{"task"}, nil,
+		),
+		timeUntilDeadline: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "time_until_deadline_seconds"),
+			"The time until the deadline of each automatic task's last check, in seconds",
+			[]string{"task"}, nil,
+		),
+		waiting: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "waiting"),
+			"1 if an automatic task is waiting for the max fee to drop, 0 if it sent its transaction",
+			[]string{"task"}, nil,
+		),
+		policyTracker: policyTracker,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *GasPolicyCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.idealMaxFee
+	channel <- collector.ceilingMaxFee
+	channel <- collector.acceptableMaxFee
+	channel <- collector.suggestedMaxFee
+	channel <- collector.timeUntilDeadline
+	channel <- collector.waiting
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *GasPolicyCollector) Collect(channel chan<- prometheus.Metric) {
+	for _, decision := range collector.policyTracker.GetDecisions() {
+		waiting := float64(1)
+		if decision.Send {
+			waiting = 0
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.idealMaxFee, prometheus.GaugeValue, decision.IdealGwei, decision.Task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.ceilingMaxFee, prometheus.GaugeValue, decision.CeilingGwei, decision.Task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.acceptableMaxFee, prometheus.GaugeValue, decision.AcceptableGwei, decision.Task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.suggestedMaxFee, prometheus.GaugeValue, decision.SuggestedGwei, decision.Task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.waiting, prometheus.GaugeValue, waiting, decision.Task)
+
+		// Optional tasks don't have a deadline
+		if !decision.Deadline.IsZero() {
+			remaining := math.Max(0, time.Until(decision.Deadline).Seconds())
+			channel <- prometheus.MustNewConstMetric(
+				collector.timeUntilDeadline, prometheus.GaugeValue, remaining, decision.Task)
+		}
+	}
+}
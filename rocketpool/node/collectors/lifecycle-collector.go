@@ -0,0 +1,70 @@
+package collectors
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+)
+
+// Represents the collector for the lifecycle stages of the node's minipools
+type LifecycleCollector struct {
+	// The number of the node's minipools in each lifecycle stage
+	stageCount *prometheus.Desc
+
+	// The time left until each of the node's waiting minipools can move on to its next stage
+	waitRemaining *prometheus.Desc
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The thread-safe locker for the network state
+	stateLocker *StateLocker
+}
+
+// Create a new LifecycleCollector instance
+func NewLifecycleCollector(nodeAddress common.Address, stateLocker *StateLocker) *LifecycleCollector {
+	subsystem := "minipool_lifecycle"
+	return &LifecycleCollector{
+		stageCount: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "stage_count"),
+			"The number of the node's minipools in each lifecycle stage",
+			[]string{"stage"}, nil,
+		),
+		waitRemaining: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "wait_remaining_seconds"),
+			"The time left until each of the node's waiting minipools can move on to its next stage, in seconds",
+			[]string{"minipool", "stage"}, nil,
+		),
+		nodeAddress: nodeAddress,
+		stateLocker: stateLocker,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *LifecycleCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.stageCount
+	channel <- collector.waitRemaining
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *LifecycleCollector) Collect(channel chan<- prometheus.Metric) {
+	// Get the latest state
+	state := collector.stateLocker.GetState()
+	if state == nil {
+		return
+	}
+
+	// Count the minipools in each stage
+	counts := map[lifecycle.Stage]int{}
+	blockTime := lifecycle.GetStateTime(state)
+	for _, mps := range lifecycle.GetNodeStages(state, collector.nodeAddress) {
+		counts[mps.Stage]++
+		if !mps.WaitUntil.IsZero() {
+			channel <- prometheus.MustNewConstMetric(
+				collector.waitRemaining, prometheus.GaugeValue, mps.WaitUntil.Sub(blockTime).Seconds(), mps.Address.Hex(), string(mps.Stage))
+		}
+	}
+	for _, stage := range lifecycle.Stages {
+		channel <- prometheus.MustNewConstMetric(
+			collector.stageCount, prometheus.GaugeValue, float64(counts[stage]), string(stage))
+	}
+}
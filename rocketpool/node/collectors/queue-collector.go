@@ -0,0 +1,169 @@
+package collectors
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/minipool"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Seb369888/smartnode/shared/services/config"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
+)
+
+// How long to reuse the deposit pool inflow rate before scanning the deposit events again
+const inflowRateCacheTime = 15 * time.Minute
+
+// Represents the collector for the node's minipools in the deposit queue
+type QueueCollector struct {
+	// The average deposit pool inflow over the lookback period, in ETH per day
+	depositInflowRate *prometheus.Desc
+
+	// The queue position of each of the node's queued minipools
+	minipoolPosition *prometheus.Desc
+
+	// The estimated time until each of the node's queued minipools is assigned
+	minipoolEta *prometheus.Desc
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The event log interval for the current eth1 client
+	eventLogInterval *big.Int
+
+	// How far back to look at deposits
+	lookback time.Duration
+
+	// The cached inflow rate and when it was last updated
+	inflowRate           *big.Int
+	lastInflowRateUpdate time.Time
+
+	// Guards the inflow rate cache against concurrent scrapes
+	lock sync.Mutex
+
+	// The thread-safe locker for the network state
+	stateLocker *StateLocker
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new QueueCollector instance
+func NewQueueCollector(rp *rocketpool.RocketPool, nodeAddress common.Address, cfg *config.RocketPoolConfig, stateLocker *StateLocker) (*QueueCollector, error) {
+
+	// Get the event log interval
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, fmt.Errorf("error getting event log interval: %w", err)
+	}
+
+	subsystem := "queue"
+	return &QueueCollector{
+		depositInflowRate: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "deposit_inflow_rate"),
+			"The average deposit pool inflow over the lookback period, in ETH per day",
+			nil, nil,
+		),
+		minipoolPosition: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "minipool_position"),
+			"The queue position of each of the node's queued minipools",
+			[]string{"minipool"}, nil,
+		),
+		minipoolEta: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "minipool_eta_seconds"),
+			"The estimated time until each of the node's queued minipools is assigned, in seconds",
+			[]string{"minipool"}, nil,
+		),
+		rp:               rp,
+		nodeAddress:      nodeAddress,
+		eventLogInterval: big.NewInt(int64(eventLogInterval)),
+		lookback:         time.Duration(cfg.Smartnode.QueueEtaLookback.Value.(uint64)) * 24 * time.Hour,
+		stateLocker:      stateLocker,
+		logPrefix:        "Queue Collector",
+	}, nil
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *QueueCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.depositInflowRate
+	channel <- collector.minipoolPosition
+	channel <- collector.minipoolEta
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *QueueCollector) Collect(channel chan<- prometheus.Metric) {
+	// Get the latest state
+	state := collector.stateLocker.GetState()
+	if state == nil || !state.IsAtlasDeployed {
+		return
+	}
+
+	// Get the positions of the node's minipools that are waiting for a deposit
+	positions := map[common.Address]int64{}
+	for _, mpd := range state.MinipoolDetailsByNode[collector.nodeAddress] {
+		if mpd.Status != types.Prelaunch || mpd.UserDepositAssigned {
+			continue
+		}
+		position, err := minipool.GetQueuePositionOfMinipool(collector.rp, mpd.MinipoolAddress, nil)
+		if err != nil {
+			collector.logError(err)
+			return
+		}
+		if position > 0 {
+			positions[mpd.MinipoolAddress] = position
+		}
+	}
+	if len(positions) == 0 {
+		return
+	}
+
+	// Update the inflow rate if it's stale
+	inflowRate, err := collector.getInflowRate(state.BeaconConfig.SecondsPerSlot)
+	if err != nil {
+		collector.logError(fmt.Errorf("Error getting deposit pool inflow rate: %w", err))
+		return
+	}
+
+	inflowPerDay := big.NewInt(0).Mul(inflowRate, big.NewInt(int64((24 * time.Hour).Seconds())))
+	channel <- prometheus.MustNewConstMetric(
+		collector.depositInflowRate, prometheus.GaugeValue, eth.WeiToEth(inflowPerDay))
+
+	// Estimate the wait time of each minipool; unknown estimates are left out
+	for address, position := range positions {
+		channel <- prometheus.MustNewConstMetric(
+			collector.minipoolPosition, prometheus.GaugeValue, float64(position), address.Hex())
+
+		estimate := rputils.EstimateQueueWaitTime(position, state.NetworkDetails.QueueLength, state.NetworkDetails.QueueCapacity.Total, state.NetworkDetails.DepositPoolBalance, inflowRate)
+		if estimate.Known {
+			channel <- prometheus.MustNewConstMetric(
+				collector.minipoolEta, prometheus.GaugeValue, estimate.TimeToAssignment.Seconds(), address.Hex())
+		}
+	}
+}
+
+// Get the deposit pool inflow rate, refreshing the cached value if it's stale
+func (collector *QueueCollector) getInflowRate(secondsPerSlot uint64) (*big.Int, error) {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	if collector.inflowRate == nil || time.Since(collector.lastInflowRateUpdate) > inflowRateCacheTime {
+		inflowRate, err := rputils.GetDepositPoolInflowRate(collector.rp, collector.lookback, secondsPerSlot, collector.eventLogInterval)
+		if err != nil {
+			return nil, err
+		}
+		collector.inflowRate = inflowRate
+		collector.lastInflowRateUpdate = time.Now()
+	}
+	return collector.inflowRate, nil
+}
+
+// Log error messages
+func (collector *QueueCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
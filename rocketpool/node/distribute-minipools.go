@@ -3,10 +3,10 @@ package node
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/Seb369888/poolsea-go/minipool"
 	"github.com/Seb369888/poolsea-go/rocketpool"
-	rptypes "github.com/Seb369888/poolsea-go/types"
 	"github.com/Seb369888/poolsea-go/utils/eth"
 	rpstate "github.com/Seb369888/poolsea-go/utils/state"
 	"github.com/docker/docker/client"
@@ -18,6 +18,8 @@ import (
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
 	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/services/notify"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -33,7 +35,9 @@ type distributeMinipools struct {
 	rp                  *rocketpool.RocketPool
 	bc                  beacon.Client
 	d                   *client.Client
-	gasThreshold        float64
+	notifier            *notify.Notifier
+	gasPolicy           rpgas.GasPolicy
+	policyTracker       *rpgas.GasPolicyTracker
 	distributeThreshold *big.Int
 	disabled            bool
 	eight               *big.Int
@@ -43,7 +47,7 @@ type distributeMinipools struct {
 }
 
 // Create distribute minipools task
-func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distributeMinipools, error) {
+func newDistributeMinipools(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*distributeMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -66,12 +70,21 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 	if err != nil {
 		return nil, err
 	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("distribute", cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if auto-distributing is disabled
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 	distributeThreshold := cfg.Smartnode.DistributeThreshold.Value.(float64)
 	disabled := false
-	if gasThreshold == 0 {
+	if gasPolicy.IdealGwei == 0 {
 		logger.Println("Automatic tx gas threshold is 0, disabling auto-distribute.")
 		disabled = true
 	} else {
@@ -113,7 +126,9 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 		rp:                  rp,
 		bc:                  bc,
 		d:                   d,
-		gasThreshold:        gasThreshold,
+		notifier:            notifier,
+		gasPolicy:           gasPolicy,
+		policyTracker:       policyTracker,
 		distributeThreshold: eth.EthToWei(distributeThreshold),
 		disabled:            disabled,
 		eight:               eth.EthToWei(8),
@@ -184,13 +199,10 @@ func (t *distributeMinipools) run(state *state.NetworkState) error {
 // Get distributable minipools
 func (t *distributeMinipools) getDistributableMinipools(nodeAddress common.Address, state *state.NetworkState, opts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
 
-	// Filter minipools by status
+	// Filter minipools by lifecycle stage; only minipools that are staking (including ones reducing their bond or exiting) can be distributed
 	distributableMinipools := []*rpstate.NativeMinipoolDetails{}
-	for _, mpd := range state.MinipoolDetailsByNode[nodeAddress] {
-		if mpd.Status != rptypes.Staking || mpd.Finalised {
-			// Ignore minipools that aren't staking and non-finalized
-			continue
-		}
+	for _, mps := range lifecycle.GetNodeMinipoolsInStage(state, nodeAddress, lifecycle.Stage_Staking, lifecycle.Stage_BondReductionPending, lifecycle.Stage_Exiting) {
+		mpd := mps.Details
 		if mpd.Version < 3 {
 			// Ignore minipools with legacy delegates
 			continue
@@ -245,16 +257,20 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+	// Check the max fee against the gas policy; distributing is optional, so there is no deadline
+	decision := t.gasPolicy.Evaluate(maxFee, time.Time{}, time.Time{})
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
 		return false, nil
 	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
 
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
@@ -274,6 +290,7 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 
 	// Log
 	t.log.Printlnf("Successfully distributed balance of minipool %s.", mp.GetAddress().Hex())
+	t.notifier.Notify(notify.BalanceDistributed(mpd.MinipoolAddress, eth.WeiToEth(mpd.DistributableBalance), hash))
 
 	// Return
 	return true, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/notify"
 	rprewards "github.com/Seb369888/smartnode/shared/services/rewards"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
@@ -28,6 +29,7 @@ type downloadRewardsTrees struct {
 	rp  *rocketpool.RocketPool
 	d   *client.Client
 	bc  beacon.Client
+	n   *notify.Notifier
 }
 
 // Create manage fee recipient task
@@ -54,6 +56,10 @@ func newDownloadRewardsTrees(c *cli.Context, logger log.ColorLogger) (*downloadR
 	if err != nil {
 		return nil, err
 	}
+	n, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &downloadRewardsTrees{
@@ -64,6 +70,7 @@ func newDownloadRewardsTrees(c *cli.Context, logger log.ColorLogger) (*downloadR
 		rp:  rp,
 		d:   d,
 		bc:  bc,
+		n:   n,
 	}, nil
 
 }
@@ -133,6 +140,7 @@ func (d *downloadRewardsTrees) run(state *state.NetworkState) error {
 			return err
 		}
 		fmt.Println("done!")
+		d.n.Notify(notify.RewardsTreeDownloaded(missingInterval))
 	}
 
 	return nil
@@ -8,13 +8,14 @@ import (
 
 	"github.com/Seb369888/smartnode/rocketpool/node/collectors"
 	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/gas"
 	"github.com/Seb369888/smartnode/shared/utils/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker, policyTracker *gas.GasPolicyTracker) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -66,6 +67,12 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	trustedNodeCollector := collectors.NewTrustedNodeCollector(rp, bc, nodeAccount.Address, cfg, stateLocker)
 	beaconCollector := collectors.NewBeaconCollector(rp, bc, ec, nodeAccount.Address, stateLocker)
 	smoothingPoolCollector := collectors.NewSmoothingPoolCollector(rp, ec, stateLocker)
+	queueCollector, err := collectors.NewQueueCollector(rp, nodeAccount.Address, cfg, stateLocker)
+	if err != nil {
+		return fmt.Errorf("Error creating queue collector: %w", err)
+	}
+	gasPolicyCollector := collectors.NewGasPolicyCollector(policyTracker)
+	lifecycleCollector := collectors.NewLifecycleCollector(nodeAccount.Address, stateLocker)
 
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
@@ -78,6 +85,9 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	registry.MustRegister(trustedNodeCollector)
 	registry.MustRegister(beaconCollector)
 	registry.MustRegister(smoothingPoolCollector)
+	registry.MustRegister(queueCollector)
+	registry.MustRegister(gasPolicyCollector)
+	registry.MustRegister(lifecycleCollector)
 
 	// Set up snapshot checking if enabled
 	votingId := cfg.Smartnode.GetVotingSnapshotID()
@@ -16,6 +16,8 @@ import (
 	"github.com/Seb369888/smartnode/rocketpool/node/collectors"
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/Seb369888/smartnode/shared/services/wallet/keystore/nimbus"
@@ -39,6 +41,11 @@ const (
 	PromoteMinipoolsColor        = color.FgMagenta
 	ReduceBondAmountColor        = color.FgHiBlue
 	DistributeMinipoolsColor     = color.FgHiGreen
+	BackupNodeStateColor         = color.FgCyan
+	BidAuctionLotsColor          = color.FgHiMagenta
+	RecordAnalyticsColor         = color.FgWhite
+	AutoRestakeColor             = color.FgHiBlack
+	CheckAlertsColor             = color.FgHiRed
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 	UpdateColor                  = color.FgHiWhite
@@ -74,6 +81,15 @@ func run(c *cli.Context) error {
 	// Configure
 	configureHTTP()
 
+	// Start the password agent if the password is only held in memory, so the other processes can get it from this one
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return err
+	}
+	if err := pm.StartAgent(); err != nil {
+		return err
+	}
+
 	// Wait until node is registered
 	if err := services.WaitNodeRegistered(c, true); err != nil {
 		return err
@@ -112,21 +128,30 @@ func run(c *cli.Context) error {
 		return err
 	}
 	stateLocker := collectors.NewStateLocker()
+	policyTracker := gas.NewGasPolicyTracker()
+	eventLog, err := lifecycle.NewEventLog(cfg.Smartnode.GetMinipoolEventLogPath())
+	if err != nil {
+		return err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return err
+	}
 
 	// Initialize tasks
 	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
 	if err != nil {
 		return err
 	}
-	distributeMinipools, err := newDistributeMinipools(c, log.NewColorLogger(DistributeMinipoolsColor))
+	distributeMinipools, err := newDistributeMinipools(c, log.NewColorLogger(DistributeMinipoolsColor), policyTracker)
 	if err != nil {
 		return err
 	}
-	stakePrelaunchMinipools, err := newStakePrelaunchMinipools(c, log.NewColorLogger(StakePrelaunchMinipoolsColor))
+	stakePrelaunchMinipools, err := newStakePrelaunchMinipools(c, log.NewColorLogger(StakePrelaunchMinipoolsColor), policyTracker)
 	if err != nil {
 		return err
 	}
-	promoteMinipools, err := newPromoteMinipools(c, log.NewColorLogger(PromoteMinipoolsColor))
+	promoteMinipools, err := newPromoteMinipools(c, log.NewColorLogger(PromoteMinipoolsColor), policyTracker)
 	if err != nil {
 		return err
 	}
@@ -134,7 +159,27 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	reduceBonds, err := newReduceBonds(c, log.NewColorLogger(ReduceBondAmountColor))
+	reduceBonds, err := newReduceBonds(c, log.NewColorLogger(ReduceBondAmountColor), policyTracker)
+	if err != nil {
+		return err
+	}
+	autoRestake, err := newAutoRestake(c, log.NewColorLogger(AutoRestakeColor), policyTracker)
+	if err != nil {
+		return err
+	}
+	checkAlerts, err := newCheckAlerts(c, log.NewColorLogger(CheckAlertsColor))
+	if err != nil {
+		return err
+	}
+	backupNodeState, err := newBackupNodeState(c, log.NewColorLogger(BackupNodeStateColor))
+	if err != nil {
+		return err
+	}
+	bidAuctionLots, err := newBidAuctionLots(c, log.NewColorLogger(BidAuctionLotsColor), policyTracker)
+	if err != nil {
+		return err
+	}
+	recordAnalytics, err := newRecordAnalytics(c, log.NewColorLogger(RecordAnalyticsColor), nodeAccount.Address)
 	if err != nil {
 		return err
 	}
@@ -180,6 +225,24 @@ func run(c *cli.Context) error {
 			}
 			stateLocker.UpdateState(state, totalEffectiveStake)
 
+			// Record the lifecycle transitions of the node's minipools
+			transitions, err := eventLog.Update(state, nodeAccount.Address)
+			if err != nil {
+				errorLog.Println(err)
+			}
+			for _, transition := range transitions {
+				if transition.From == lifecycle.Stage_Unknown {
+					updateLog.Printlnf("Minipool %s is in the %s stage.", transition.Minipool.Hex(), transition.To)
+				} else {
+					updateLog.Printlnf("Minipool %s moved from the %s stage to the %s stage at block %d.", transition.Minipool.Hex(), transition.From, transition.To, transition.Block)
+				}
+			}
+
+			// Record the node's analytics
+			if err := notifier.TrackTask("analytics", recordAnalytics.run(state)); err != nil {
+				errorLog.Println(err)
+			}
+
 			// Check for Atlas
 			if !isAtlasDeployedMasterFlag && state.IsAtlasDeployed {
 				printAtlasMessage(&updateLog)
@@ -187,37 +250,61 @@ func run(c *cli.Context) error {
 			}
 
 			// Manage the fee recipient for the node
-			if err := manageFeeRecipient.run(state); err != nil {
+			if err := notifier.TrackTask("fee recipient", manageFeeRecipient.run(state)); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
 			// Run the rewards download check
-			if err := downloadRewardsTrees.run(state); err != nil {
+			if err := notifier.TrackTask("rewards tree download", downloadRewardsTrees.run(state)); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
 			// Run the minipool stake check
-			if err := stakePrelaunchMinipools.run(state); err != nil {
+			if err := notifier.TrackTask("minipool stake", stakePrelaunchMinipools.run(state)); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
 			// Run the balance distribution check
-			if err := distributeMinipools.run(state); err != nil {
+			if err := notifier.TrackTask("balance distribution", distributeMinipools.run(state)); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
 			// Run the reduce bond check
-			if err := reduceBonds.run(state); err != nil {
+			if err := notifier.TrackTask("bond reduction", reduceBonds.run(state)); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
 			// Run the minipool promotion check
-			if err := promoteMinipools.run(state); err != nil {
+			if err := notifier.TrackTask("minipool promotion", promoteMinipools.run(state)); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(taskCooldown)
+
+			// Run the auto-restake check
+			if err := notifier.TrackTask("auto-restake", autoRestake.run(state)); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(taskCooldown)
+
+			// Run the alert rules
+			if err := notifier.TrackTask("alert", checkAlerts.run(state)); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(taskCooldown)
+
+			// Run the automatic backup check
+			if err := notifier.TrackTask("backup", backupNodeState.run()); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(taskCooldown)
+
+			// Run the auction bidding check
+			if err := notifier.TrackTask("auction bidding", bidAuctionLots.run()); err != nil {
 				errorLog.Println(err)
 			}
 
@@ -228,7 +315,7 @@ func run(c *cli.Context) error {
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker, policyTracker)
 		if err != nil {
 			errorLog.Println(err)
 		}
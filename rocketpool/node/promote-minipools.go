@@ -1,14 +1,12 @@
 package node
 
 import (
-	"context"
 	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/Seb369888/poolsea-go/minipool"
 	"github.com/Seb369888/poolsea-go/rocketpool"
-	"github.com/Seb369888/poolsea-go/types"
 	"github.com/Seb369888/poolsea-go/utils/eth"
 	rpstate "github.com/Seb369888/poolsea-go/utils/state"
 	"github.com/docker/docker/client"
@@ -19,6 +17,7 @@ import (
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/config"
 	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -33,14 +32,15 @@ type promoteMinipools struct {
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
 	d              *client.Client
-	gasThreshold   float64
+	gasPolicy      rpgas.GasPolicy
+	policyTracker  *rpgas.GasPolicyTracker
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
 }
 
 // Create promote minipools task
-func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipools, error) {
+func newPromoteMinipools(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*promoteMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -60,8 +60,6 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 		return nil, err
 	}
 
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
-
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
 	var maxFee *big.Int
@@ -81,6 +79,12 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 		priorityFee = eth.GweiToWei(priorityFeeGwei)
 	}
 
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("promote", cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return task
 	return &promoteMinipools{
 		c:              c,
@@ -89,7 +93,8 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 		w:              w,
 		rp:             rp,
 		d:              d,
-		gasThreshold:   gasThreshold,
+		gasPolicy:      gasPolicy,
+		policyTracker:  policyTracker,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
@@ -148,29 +153,17 @@ func (t *promoteMinipools) run(state *state.NetworkState) error {
 // Get vacant minipools
 func (t *promoteMinipools) getVacantMinipools(nodeAddress common.Address, state *state.NetworkState, opts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
 
+	// Filter vacant minipools by lifecycle stage
 	vacantMinipools := []*rpstate.NativeMinipoolDetails{}
-
-	// Get the scrub period
-	scrubPeriod := state.NetworkDetails.PromotionScrubPeriod
-
-	// Get the time of the target block
-	block, err := t.rp.Client.HeaderByNumber(context.Background(), opts.BlockNumber)
-	if err != nil {
-		return nil, fmt.Errorf("Can't get the latest block time: %w", err)
-	}
-	blockTime := time.Unix(int64(block.Time), 0)
-
-	// Filter by vacancy
-	mpds := state.MinipoolDetailsByNode[nodeAddress]
-	for _, mpd := range mpds {
-		if mpd.IsVacant && mpd.Status == types.Prelaunch {
-			creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
-			remainingTime := creationTime.Add(scrubPeriod).Sub(blockTime)
-			if remainingTime < 0 {
-				vacantMinipools = append(vacantMinipools, mpd)
-			} else {
-				t.log.Printlnf("Minipool %s has %s left until it can be promoted.", mpd.MinipoolAddress.Hex(), remainingTime)
-			}
+	blockTime := lifecycle.GetStateTime(state)
+	for _, mps := range lifecycle.GetNodeMinipoolsInStage(state, nodeAddress, lifecycle.Stage_ScrubWindow, lifecycle.Stage_Prelaunch) {
+		if !mps.Details.IsVacant {
+			continue
+		}
+		if mps.Stage == lifecycle.Stage_Prelaunch {
+			vacantMinipools = append(vacantMinipools, mps.Details)
+		} else {
+			t.log.Printlnf("Minipool %s has %s left until it can be promoted.", mps.Address.Hex(), mps.WaitUntil.Sub(blockTime))
 		}
 	}
 
@@ -216,27 +209,26 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
-		// Check for the timeout buffer
-		creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
-		isDue, timeUntilDue, err := api.IsTransactionDue(t.rp, creationTime)
-		if err != nil {
-			t.log.Printlnf("Error checking if minipool is due: %s\nPromoting now for safety...", err.Error())
-		}
-		if !isDue {
-			t.log.Printlnf("Time until promoting will be forced for safety: %s", timeUntilDue)
-			return false, nil
-		}
-
-		t.log.Println("NOTICE: The minipool has exceeded half of the timeout period, so it will be force-promoted at the current gas price.")
+	// Check the max fee against the gas policy, which accepts more as the launch timeout approaches
+	creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
+	dueTime, err := api.GetTransactionDueTime(t.rp, creationTime)
+	if err != nil {
+		t.log.Printlnf("Error getting the promotion deadline: %s\nTreating it as due for safety...", err.Error())
+		dueTime = creationTime
+	}
+	decision := t.gasPolicy.Evaluate(maxFee, creationTime, dueTime)
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
+		return false, nil
 	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
 
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
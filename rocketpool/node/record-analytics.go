@@ -0,0 +1,184 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/rewards"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/analytics"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	rprewards "github.com/Seb369888/smartnode/shared/services/rewards"
+	"github.com/Seb369888/smartnode/shared/services/state"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Record analytics task
+type recordAnalytics struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.RocketPoolConfig
+	rp          *rocketpool.RocketPool
+	nodeAddress common.Address
+	path        string
+	retention   time.Duration
+}
+
+// Create record analytics task
+func newRecordAnalytics(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address) (*recordAnalytics, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &recordAnalytics{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		rp:          rp,
+		nodeAddress: nodeAddress,
+		path:        os.ExpandEnv(cfg.Smartnode.GetAnalyticsPath(true)),
+		retention:   time.Duration(cfg.Smartnode.AnalyticsRetention.Value.(uint64)) * 24 * time.Hour,
+	}, nil
+
+}
+
+// Add a snapshot of the node to the analytics store and remove the ones that are too old
+func (t *recordAnalytics) run(state *state.NetworkState) error {
+
+	// Check if analytics are disabled
+	if t.retention == 0 {
+		return nil
+	}
+
+	// Check if the node is in the state
+	nd, exists := state.NodeDetailsByAddress[t.nodeAddress]
+	if !exists {
+		return nil
+	}
+
+	// The store is only kept open while writing so the API can read it in between
+	store, err := analytics.Open(t.path, false)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	// Get the node record
+	genesisTime := time.Unix(int64(state.BeaconConfig.GenesisTime), 0)
+	snapshotTime := genesisTime.Add(time.Duration(state.BeaconSlotNumber*state.BeaconConfig.SecondsPerSlot) * time.Second)
+	rplStake := eth.WeiToEth(nd.RplStake)
+	rplPrice := eth.WeiToEth(state.NetworkDetails.RplPrice)
+	collateralRatio := float64(0)
+	if nd.EthMatched.Sign() > 0 {
+		collateralRatio = rplStake * rplPrice / eth.WeiToEth(nd.EthMatched)
+	}
+	snapshot := analytics.Snapshot{
+		Time:  snapshotTime,
+		Block: state.ElBlockNumber,
+		Node: analytics.NodeRecord{
+			RplStake:          rplStake,
+			EffectiveRplStake: eth.WeiToEth(nd.EffectiveRPLStake),
+			CollateralRatio:   collateralRatio,
+			RplPrice:          rplPrice,
+			RethRate:          state.NetworkDetails.RETHExchangeRate,
+			EthBalance:        eth.WeiToEth(nd.BalanceETH),
+			RplBalance:        eth.WeiToEth(nd.BalanceRPL),
+			MinipoolCount:     nd.MinipoolCount.Uint64(),
+		},
+	}
+
+	// Get the minipool records
+	for _, mpd := range state.MinipoolDetailsByNode[t.nodeAddress] {
+		if mpd.Finalised {
+			continue
+		}
+		record := analytics.MinipoolRecord{
+			Address:          mpd.MinipoolAddress,
+			Status:           mpd.Status.String(),
+			NodeDeposit:      eth.WeiToEth(mpd.NodeDepositBalance),
+			UserDeposit:      eth.WeiToEth(mpd.UserDepositBalance),
+			ExecutionBalance: eth.WeiToEth(mpd.Balance),
+		}
+		if validator, exists := state.ValidatorDetails[mpd.Pubkey]; exists {
+			record.BeaconBalance = eth.WeiToEth(eth.GweiToWei(float64(validator.Balance)))
+		}
+		snapshot.Minipools = append(snapshot.Minipools, record)
+	}
+
+	// Save it and prune the old ones
+	if err := store.AddSnapshot(snapshot); err != nil {
+		return err
+	}
+	pruned, err := store.Prune(snapshotTime.Add(-t.retention))
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		t.log.Printlnf("Removed %d analytics snapshot(s) older than %.0f days.", pruned, t.retention.Hours()/24)
+	}
+
+	// Record the rewards from any intervals that finished since the last run
+	return t.recordRewards(store)
+
+}
+
+// Add the node's rewards from each finished interval that isn't in the store yet.
+// There's one record per interval, so they're kept instead of being pruned with the snapshots.
+func (t *recordAnalytics) recordRewards(store *analytics.Store) error {
+
+	currentIndex, err := rewards.GetRewardIndex(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("error getting rewards interval: %w", err)
+	}
+	for interval := uint64(0); interval < currentIndex.Uint64(); interval++ {
+		recorded, err := store.HasRewards(interval)
+		if err != nil {
+			return err
+		}
+		if recorded {
+			continue
+		}
+
+		// Intervals are recorded once their tree file has been downloaded
+		if _, err := os.Stat(t.cfg.Smartnode.GetRewardsTreePath(interval, true)); err != nil {
+			continue
+		}
+		intervalInfo, err := rprewards.GetIntervalInfo(t.rp, t.cfg, t.nodeAddress, interval)
+		if err != nil {
+			return fmt.Errorf("error getting rewards for interval %d: %w", interval, err)
+		}
+		if !intervalInfo.TreeFileExists || !intervalInfo.MerkleRootValid {
+			continue
+		}
+
+		record := analytics.RewardsRecord{
+			Interval: interval,
+			Start:    intervalInfo.StartTime,
+			End:      intervalInfo.EndTime,
+		}
+		if intervalInfo.NodeExists {
+			record.CollateralRpl = eth.WeiToEth(&intervalInfo.CollateralRplAmount.Int)
+			record.OracleDaoRpl = eth.WeiToEth(&intervalInfo.ODaoRplAmount.Int)
+			record.SmoothingPoolEth = eth.WeiToEth(&intervalInfo.SmoothingPoolEthAmount.Int)
+		}
+		if err := store.AddRewards(record); err != nil {
+			return err
+		}
+	}
+	return nil
+
+}
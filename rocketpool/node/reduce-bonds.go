@@ -21,6 +21,8 @@ import (
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/config"
 	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/services/notify"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -35,7 +37,9 @@ type reduceBonds struct {
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
 	d              *client.Client
-	gasThreshold   float64
+	notifier       *notify.Notifier
+	gasPolicy      rpgas.GasPolicy
+	policyTracker  *rpgas.GasPolicyTracker
 	disabled       bool
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -52,7 +56,7 @@ type minipoolBondReductionDetails struct {
 }
 
 // Create reduce bonds task
-func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error) {
+func newReduceBonds(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*reduceBonds, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -72,10 +76,20 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 		return nil, err
 	}
 
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("reduce-bond", cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if auto-bond-reduction is disabled
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 	disabled := false
-	if gasThreshold == 0 {
+	if gasPolicy.IdealGwei == 0 {
 		logger.Println("Automatic tx gas threshold is 0, disabling auto-reduce.")
 		disabled = true
 	}
@@ -107,7 +121,9 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 		w:              w,
 		rp:             rp,
 		d:              d,
-		gasThreshold:   gasThreshold,
+		notifier:       notifier,
+		gasPolicy:      gasPolicy,
+		policyTracker:  policyTracker,
 		disabled:       disabled,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
@@ -166,8 +182,22 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) are ready for bond reduction...", len(minipools))
 
-	// Workaround for the fee distribution issue
-	success, err := t.forceFeeDistribution()
+	// Get the gas policy deadlines of each minipool, and the earliest one
+	starts := make([]time.Time, len(minipools))
+	deadlines := make([]time.Time, len(minipools))
+	var earliest int
+	for i, mp := range minipools {
+		starts[i], deadlines[i], err = t.getGasPolicyWindow(mp, windowStart, windowLength, opts)
+		if err != nil {
+			return err
+		}
+		if deadlines[i].Before(deadlines[earliest]) {
+			earliest = i
+		}
+	}
+
+	// Workaround for the fee distribution issue; this blocks every reduction so it uses the earliest deadline
+	success, err := t.forceFeeDistribution(starts[earliest], deadlines[earliest])
 	if err != nil {
 		return err
 	}
@@ -177,8 +207,8 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 
 	// Reduce bonds
 	successCount := 0
-	for _, mp := range minipools {
-		success, err := t.reduceBond(mp, windowStart, windowLength, latestBlockTime, opts)
+	for i, mp := range minipools {
+		success, err := t.reduceBond(mp, windowStart, windowLength, latestBlockTime, starts[i], deadlines[i], opts)
 		if err != nil {
 			t.log.Println(fmt.Errorf("could not reduce bond for minipool %s: %w", mp.MinipoolAddress.Hex(), err))
 			return err
@@ -194,7 +224,7 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 }
 
 // Temp mitigation for the
-func (t *reduceBonds) forceFeeDistribution() (bool, error) {
+func (t *reduceBonds) forceFeeDistribution(policyStart time.Time, policyDeadline time.Time) (bool, error) {
 
 	// Get node account
 	nodeAccount, err := t.w.GetNodeAccount()
@@ -272,16 +302,20 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+	// Check the max fee against the gas policy
+	decision := t.gasPolicy.Evaluate(maxFee, policyStart, policyDeadline)
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
 		return false, nil
 	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
 
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
@@ -308,33 +342,18 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 // Get reduceable minipools
 func (t *reduceBonds) getReduceableMinipools(nodeAddress common.Address, windowStart time.Duration, windowLength time.Duration, latestBlockTime time.Time, state *state.NetworkState, opts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
 
-	// Filter minipools
+	// Filter minipools by lifecycle stage
 	reduceableMinipools := []*rpstate.NativeMinipoolDetails{}
-	for _, mpd := range state.MinipoolDetailsByNode[nodeAddress] {
-
-		// TEMP
-		reduceBondTime, err := minipool.GetReduceBondTime(t.rp, mpd.MinipoolAddress, opts)
-		if err != nil {
-			return nil, fmt.Errorf("error getting reduce bond time for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
+	for _, mps := range lifecycle.GetNodeMinipoolsInStage(state, nodeAddress, lifecycle.Stage_BondReductionPending) {
+		depositBalance := eth.WeiToEth(mps.Details.NodeDepositBalance)
+		if depositBalance != 16_000_000 {
+			continue
 		}
-		reduceBondCancelled, err := minipool.GetReduceBondCancelled(t.rp, mpd.MinipoolAddress, opts)
-		if err != nil {
-			return nil, fmt.Errorf("error getting reduce bond cancelled for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
-		}
-
-		depositBalance := eth.WeiToEth(mpd.NodeDepositBalance)
-		timeSinceReductionStart := latestBlockTime.Sub(reduceBondTime)
-
-		if depositBalance == 16_000_000 &&
-			timeSinceReductionStart < (windowStart+windowLength) &&
-			!reduceBondCancelled &&
-			mpd.Status == types.Staking {
-			if timeSinceReductionStart > windowStart {
-				reduceableMinipools = append(reduceableMinipools, mpd)
-			} else {
-				remainingTime := windowStart - timeSinceReductionStart
-				t.log.Printlnf("Minipool %s has %s left until it can have its bond reduced.", mpd.MinipoolAddress.Hex(), remainingTime)
-			}
+		if mps.WaitUntil.IsZero() {
+			reduceableMinipools = append(reduceableMinipools, mps.Details)
+		} else {
+			remainingTime := mps.WaitUntil.Sub(latestBlockTime)
+			t.log.Printlnf("Minipool %s has %s left until it can have its bond reduced.", mps.Address.Hex(), remainingTime)
 		}
 	}
 
@@ -344,7 +363,7 @@ func (t *reduceBonds) getReduceableMinipools(nodeAddress common.Address, windowS
 }
 
 // Reduce a minipool's bond
-func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart time.Duration, windowLength time.Duration, latestBlockTime time.Time, callOpts *bind.CallOpts) (bool, error) {
+func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart time.Duration, windowLength time.Duration, latestBlockTime time.Time, policyStart time.Time, policyDeadline time.Time, callOpts *bind.CallOpts) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Reducing bond for minipool %s...", mpd.MinipoolAddress.Hex())
@@ -382,7 +401,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
@@ -394,13 +413,17 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 		return false, fmt.Errorf("error getting reduce bond time for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+	// Check the max fee against the gas policy, which accepts more as the end of the bond reduction window approaches
+	decision := t.gasPolicy.Evaluate(maxFee, policyStart, policyDeadline)
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
 		timeSinceReductionStart := latestBlockTime.Sub(reduceBondTime)
 		remainingTime := (windowStart + windowLength) - timeSinceReductionStart
 		t.log.Printlnf("Time until bond reduction times out: %s", remainingTime)
 		return false, nil
 	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
 
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
@@ -420,8 +443,20 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 
 	// Log
 	t.log.Printlnf("Successfully reduced bond for minipool %s.", mpd.MinipoolAddress.Hex())
+	t.notifier.Notify(notify.BondReduced(mpd.MinipoolAddress, hash))
 
 	// Return
 	return true, nil
 
 }
+
+// Get the window the gas policy ramps over for a minipool, which ends with a safety margin before its bond reduction window closes
+func (t *reduceBonds) getGasPolicyWindow(mpd *rpstate.NativeMinipoolDetails, windowStart time.Duration, windowLength time.Duration, opts *bind.CallOpts) (time.Time, time.Time, error) {
+	reduceBondTime, err := minipool.GetReduceBondTime(t.rp, mpd.MinipoolAddress, opts)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("error getting reduce bond time for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
+	}
+	start := reduceBondTime.Add(windowStart)
+	deadline := start.Add(windowLength / time.Duration(api.TimeoutSafetyFactor))
+	return start, deadline, nil
+}
@@ -1,7 +1,6 @@
 package node
 
 import (
-	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -20,6 +19,8 @@ import (
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
 	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/services/notify"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -36,14 +37,16 @@ type stakePrelaunchMinipools struct {
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
-	gasThreshold   float64
+	notifier       *notify.Notifier
+	gasPolicy      rpgas.GasPolicy
+	policyTracker  *rpgas.GasPolicyTracker
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
 }
 
 // Create stake prelaunch minipools task
-func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakePrelaunchMinipools, error) {
+func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger, policyTracker *rpgas.GasPolicyTracker) (*stakePrelaunchMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -66,8 +69,10 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
-
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
@@ -88,6 +93,12 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		priorityFee = eth.GweiToWei(priorityFeeGwei)
 	}
 
+	// Get the gas policy
+	gasPolicy, err := rpgas.NewGasPolicy("stake", cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return task
 	return &stakePrelaunchMinipools{
 		c:              c,
@@ -97,7 +108,9 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		rp:             rp,
 		bc:             bc,
 		d:              d,
-		gasThreshold:   gasThreshold,
+		notifier:       notifier,
+		gasPolicy:      gasPolicy,
+		policyTracker:  policyTracker,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
@@ -167,31 +180,17 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 // Get prelaunch minipools
 func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Address, state *state.NetworkState, opts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
 
-	// Get the scrub period
-	scrubPeriod := state.NetworkDetails.ScrubPeriod
-
-	// Get the time of the target block
-	block, err := t.rp.Client.HeaderByNumber(context.Background(), opts.BlockNumber)
-	if err != nil {
-		return nil, fmt.Errorf("Can't get the latest block time: %w", err)
-	}
-	blockTime := time.Unix(int64(block.Time), 0)
-
-	// Filter minipools by status
+	// Filter minipools by lifecycle stage; vacant minipools are promoted instead of staked
 	prelaunchMinipools := []*rpstate.NativeMinipoolDetails{}
-	for _, mpd := range state.MinipoolDetailsByNode[nodeAddress] {
-		if mpd.Status == rptypes.Prelaunch {
-			if mpd.IsVacant {
-				// Ignore vacant minipools
-				continue
-			}
-			creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
-			remainingTime := creationTime.Add(scrubPeriod).Sub(blockTime)
-			if remainingTime < 0 {
-				prelaunchMinipools = append(prelaunchMinipools, mpd)
-			} else {
-				t.log.Printlnf("Minipool %s has %s left until it can be staked.", mpd.MinipoolAddress.Hex(), remainingTime)
-			}
+	blockTime := lifecycle.GetStateTime(state)
+	for _, mps := range lifecycle.GetNodeMinipoolsInStage(state, nodeAddress, lifecycle.Stage_ScrubWindow, lifecycle.Stage_Prelaunch) {
+		if mps.Details.IsVacant {
+			continue
+		}
+		if mps.Stage == lifecycle.Stage_Prelaunch {
+			prelaunchMinipools = append(prelaunchMinipools, mps.Details)
+		} else {
+			t.log.Printlnf("Minipool %s has %s left until it can be staked.", mps.Address.Hex(), mps.WaitUntil.Sub(blockTime))
 		}
 	}
 
@@ -262,27 +261,26 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
-		// Check for the timeout buffer
-		prelaunchTime := time.Unix(mpd.StatusTime.Int64(), 0)
-		isDue, timeUntilDue, err := api.IsTransactionDue(t.rp, prelaunchTime)
-		if err != nil {
-			t.log.Printlnf("Error checking if minipool is due: %s\nStaking now for safety...", err.Error())
-		}
-		if !isDue {
-			t.log.Printlnf("Time until staking will be forced for safety: %s", timeUntilDue)
-			return false, nil
-		}
-
-		t.log.Println("NOTICE: The minipool has exceeded half of the timeout period, so it will be force-staked at the current gas price.")
+	// Check the max fee against the gas policy, which accepts more as the launch timeout approaches
+	prelaunchTime := time.Unix(mpd.StatusTime.Int64(), 0)
+	dueTime, err := api.GetTransactionDueTime(t.rp, prelaunchTime)
+	if err != nil {
+		t.log.Printlnf("Error getting the staking deadline: %s\nTreating it as due for safety...", err.Error())
+		dueTime = prelaunchTime
+	}
+	decision := t.gasPolicy.Evaluate(maxFee, prelaunchTime, dueTime)
+	t.policyTracker.Record(decision)
+	decision.Log(t.log)
+	if !decision.Send {
+		return false, nil
 	}
+	api.PrintGasInfo(gasInfo, t.log, maxFee, t.gasLimit)
 
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
@@ -306,6 +304,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 
 	// Log
 	t.log.Printlnf("Successfully staked minipool %s.", mp.GetAddress().Hex())
+	t.notifier.Notify(notify.MinipoolStaked(mp.GetAddress(), hash))
 
 	// Return
 	return true, nil
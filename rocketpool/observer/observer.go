@@ -0,0 +1,279 @@
+package observer
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/node"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fatih/color"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/rocketpool/node/collectors"
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/state"
+	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// Config
+var stateInterval, _ = time.ParseDuration("5m")
+var retryCooldown, _ = time.ParseDuration("10s")
+var totalEffectiveStakeCooldown, _ = time.ParseDuration("1h")
+
+const (
+	MaxConcurrentEth1Requests = 200
+
+	// The label added to the metrics of each observed node
+	NodeLabel = "node"
+
+	MetricsColor = color.FgHiYellow
+	ErrorColor   = color.FgRed
+	UpdateColor  = color.FgHiWhite
+)
+
+// Register observer command
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Run a read-only Poolsea metrics exporter for a set of nodes, without a wallet",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "nodes, n",
+				Usage: "A comma-separated list of the node addresses to observe",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return run(c)
+		},
+	})
+}
+
+// Run daemon
+func run(c *cli.Context) error {
+
+	// Get the nodes to observe
+	nodeAddresses, err := parseNodeAddresses(c.String("nodes"))
+	if err != nil {
+		return err
+	}
+
+	// Configure
+	http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost = MaxConcurrentEth1Requests
+
+	// Wait for the clients to sync
+	if err := services.WaitEthClientSynced(c, true); err != nil {
+		return err
+	}
+	if err := services.WaitBeaconClientSynced(c, true); err != nil {
+		return err
+	}
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+
+	// Make sure every node is registered, since the collectors expect it to be in the network state
+	for _, nodeAddress := range nodeAddresses {
+		exists, err := node.GetNodeExists(rp, nodeAddress, nil)
+		if err != nil {
+			return fmt.Errorf("error checking if node %s is registered: %w", nodeAddress.Hex(), err)
+		}
+		if !exists {
+			return fmt.Errorf("node %s is not registered with Poolsea", nodeAddress.Hex())
+		}
+	}
+
+	// Initialize loggers
+	errorLog := log.NewColorLogger(ErrorColor)
+	updateLog := log.NewColorLogger(UpdateColor)
+	updateLog.Printlnf("Observing %d node(s).", len(nodeAddresses))
+
+	// Create the state manager
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, &updateLog)
+	if err != nil {
+		return err
+	}
+	stateLocker := collectors.NewStateLocker()
+
+	// Wait group to handle the various threads
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+
+	// Timestamp for caching total effective RPL stake
+	lastTotalEffectiveStakeTime := time.Unix(0, 0)
+
+	// Run state loop
+	go func() {
+		defer wg.Done()
+		for {
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			if err != nil {
+				errorLog.Println(err)
+				time.Sleep(retryCooldown)
+				continue
+			}
+
+			// Check the BC status
+			err = services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
+			if err != nil {
+				errorLog.Println(err)
+				time.Sleep(retryCooldown)
+				continue
+			}
+
+			// Update the network state
+			updateTotalEffectiveStake := false
+			if time.Since(lastTotalEffectiveStakeTime) > totalEffectiveStakeCooldown {
+				updateTotalEffectiveStake = true
+				lastTotalEffectiveStakeTime = time.Now() // Even if the call below errors out, this will prevent contant errors related to this flag
+			}
+			state, totalEffectiveStake, err := m.GetHeadStateForNodes(nodeAddresses, updateTotalEffectiveStake)
+			if err != nil {
+				errorLog.Println(fmt.Errorf("error updating network state: %w", err))
+				time.Sleep(retryCooldown)
+				continue
+			}
+			stateLocker.UpdateState(state, totalEffectiveStake)
+
+			time.Sleep(stateInterval)
+		}
+	}()
+
+	// Run metrics loop
+	go func() {
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), nodeAddresses, stateLocker)
+		if err != nil {
+			errorLog.Println(err)
+		}
+		wg.Done()
+	}()
+
+	// Wait for both threads to stop
+	wg.Wait()
+	return nil
+
+}
+
+// Serve the network metrics once and the node metrics for each node, labelled with its address
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, nodeAddresses []common.Address, stateLocker *collectors.StateLocker) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	s, err := services.GetSnapshotDelegation(c)
+	if err != nil {
+		return err
+	}
+
+	// Set up the network collectors
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewDemandCollector(rp, stateLocker))
+	registry.MustRegister(collectors.NewPerformanceCollector(rp, stateLocker))
+	registry.MustRegister(collectors.NewSupplyCollector(rp, stateLocker))
+	registry.MustRegister(collectors.NewRplCollector(rp, cfg, stateLocker))
+	registry.MustRegister(collectors.NewOdaoCollector(rp, stateLocker))
+	registry.MustRegister(collectors.NewSmoothingPoolCollector(rp, ec, stateLocker))
+
+	// Set up the node collectors
+	votingId := cfg.Smartnode.GetVotingSnapshotID()
+	for _, nodeAddress := range nodeAddresses {
+		nodeRegistry := prometheus.WrapRegistererWith(prometheus.Labels{NodeLabel: nodeAddress.Hex()}, registry)
+		nodeRegistry.MustRegister(collectors.NewNodeCollector(rp, bc, nodeAddress, cfg, stateLocker))
+		nodeRegistry.MustRegister(collectors.NewTrustedNodeCollector(rp, bc, nodeAddress, cfg, stateLocker))
+		nodeRegistry.MustRegister(collectors.NewBeaconCollector(rp, bc, ec, nodeAddress, stateLocker))
+		queueCollector, err := collectors.NewQueueCollector(rp, nodeAddress, cfg, stateLocker)
+		if err != nil {
+			return fmt.Errorf("Error creating queue collector for node %s: %w", nodeAddress.Hex(), err)
+		}
+		nodeRegistry.MustRegister(queueCollector)
+		if s != nil {
+			votingDelegate, err := s.Delegation(nil, nodeAddress, votingId)
+			if err != nil {
+				return fmt.Errorf("Error getting delegate of node %s: %w", nodeAddress.Hex(), err)
+			}
+			nodeRegistry.MustRegister(collectors.NewSnapshotCollector(rp, cfg, nodeAddress, votingDelegate))
+		}
+	}
+
+	// Start the HTTP server
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	metricsAddress := c.GlobalString("metricsAddress")
+	metricsPort := c.GlobalUint("metricsPort")
+	logger.Printlnf("Starting observer metrics exporter on %s:%d.", metricsAddress, metricsPort)
+	metricsPath := "/metrics"
+	http.Handle(metricsPath, handler)
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html>
+            <head><title>Poolsea Observer Metrics Exporter</title></head>
+            <body>
+            <h1>Poolsea Observer Metrics Exporter</h1>
+            <p><a href='` + metricsPath + `'>Metrics</a></p>
+            </body>
+            </html>`,
+		))
+	})
+	err = http.ListenAndServe(fmt.Sprintf("%s:%d", metricsAddress, metricsPort), nil)
+	if err != nil {
+		return fmt.Errorf("Error running HTTP server: %w", err)
+	}
+
+	return nil
+
+}
+
+// Parse a comma-separated list of node addresses, ignoring duplicates
+func parseNodeAddresses(value string) ([]common.Address, error) {
+	nodeAddresses := []common.Address{}
+	seen := map[common.Address]bool{}
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		address, err := cliutils.ValidateAddress("node address", element)
+		if err != nil {
+			return nil, err
+		}
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		nodeAddresses = append(nodeAddresses, address)
+	}
+	if len(nodeAddresses) == 0 {
+		return nil, fmt.Errorf("please provide the addresses of the nodes to observe with --nodes")
+	}
+	return nodeAddresses, nil
+}
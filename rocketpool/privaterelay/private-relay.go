@@ -0,0 +1,157 @@
+package privaterelay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+const (
+	RelayColor = color.FgHiCyan
+	ErrorColor = color.FgRed
+)
+
+// A JSON-RPC request
+type rpcRequest struct {
+	Version string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// A JSON-RPC response
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// A JSON-RPC error
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Register private relay command
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Run a local stand-in for a private transaction endpoint, which forwards private transactions to the Execution client",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "address, a",
+				Usage: "The address to listen on",
+				Value: "127.0.0.1",
+			},
+			cli.UintFlag{
+				Name:  "port, p",
+				Usage: "The port to listen on",
+				Value: 8550,
+			},
+			cli.DurationFlag{
+				Name:  "delay, d",
+				Usage: "How long to hold each transaction before forwarding it, to simulate waiting for a block builder",
+			},
+			cli.BoolFlag{
+				Name:  "drop",
+				Usage: "Accept transactions but never forward them, to test the public fallback",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return run(c)
+		},
+	})
+}
+
+// Run the relay
+func run(c *cli.Context) error {
+
+	// Get services
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewColorLogger(RelayColor)
+	errorLog := log.NewColorLogger(ErrorColor)
+	delay := c.Duration("delay")
+	drop := c.Bool("drop")
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var request rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeResponse(w, rpcResponse{Error: &rpcError{Code: -32700, Message: err.Error()}})
+			return
+		}
+		response := rpcResponse{ID: request.ID}
+		if request.Method != privatetx.SendPrivateTransactionMethod {
+			response.Error = &rpcError{Code: -32601, Message: fmt.Sprintf("the method %s does not exist/is not available", request.Method)}
+			writeResponse(w, response)
+			return
+		}
+
+		// Decode the transaction
+		var params privatetx.PrivateTransaction
+		if len(request.Params) != 1 {
+			response.Error = &rpcError{Code: -32602, Message: "expected a single private transaction parameter"}
+			writeResponse(w, response)
+			return
+		}
+		if err := json.Unmarshal(request.Params[0], &params); err != nil {
+			response.Error = &rpcError{Code: -32602, Message: err.Error()}
+			writeResponse(w, response)
+			return
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(params.Tx); err != nil {
+			response.Error = &rpcError{Code: -32602, Message: err.Error()}
+			writeResponse(w, response)
+			return
+		}
+
+		// Accept it and forward it in the background
+		logger.Printlnf("Accepted private transaction %s (max block %d).", tx.Hash().Hex(), uint64(params.MaxBlockNumber))
+		response.Result = tx.Hash()
+		writeResponse(w, response)
+		if drop {
+			logger.Printlnf("Dropping transaction %s.", tx.Hash().Hex())
+			return
+		}
+		go func() {
+			time.Sleep(delay)
+			if err := ec.SendTransaction(context.Background(), tx); err != nil {
+				errorLog.Printlnf("Error forwarding transaction %s: %s", tx.Hash().Hex(), err.Error())
+				return
+			}
+			logger.Printlnf("Forwarded transaction %s to the Execution client.", tx.Hash().Hex())
+		}()
+	})
+
+	// Start the HTTP server
+	address := fmt.Sprintf("%s:%d", c.String("address"), c.Uint("port"))
+	logger.Printlnf("Starting private transaction relay on %s.", address)
+	if err := http.ListenAndServe(address, nil); err != nil {
+		return fmt.Errorf("Error running HTTP server: %w", err)
+	}
+	return nil
+
+}
+
+// Write a JSON-RPC response
+func writeResponse(w http.ResponseWriter, response rpcResponse) {
+	response.Version = "2.0"
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/Seb369888/smartnode/rocketpool/api"
 	"github.com/Seb369888/smartnode/rocketpool/node"
+	"github.com/Seb369888/smartnode/rocketpool/observer"
+	"github.com/Seb369888/smartnode/rocketpool/privaterelay"
 	"github.com/Seb369888/smartnode/rocketpool/watchtower"
 	"github.com/Seb369888/smartnode/shared"
 	apiutils "github.com/Seb369888/smartnode/shared/utils/api"
@@ -120,6 +122,14 @@ func main() {
 			Name:  "force-fallbacks",
 			Usage: "Set this to true if you know the primary EC or CC is offline and want to bypass its health checks, and just use the fallback EC and CC instead",
 		},
+		cli.StringFlag{
+			Name:  "watch",
+			Usage: "Run read-only queries against this node `address` instead of the node wallet's; nothing can be signed in this mode",
+		},
+		cli.BoolFlag{
+			Name:  "simulate",
+			Usage: "Simulate each transaction against the pending block and report the result instead of sending it",
+		},
 		cli.BoolFlag{
 			Name:  "use-protected-api",
 			Usage: "Set this to true to use the Flashbots Protect RPC instead of your local Execution Client. Useful to ensure your transactions aren't front-run.",
@@ -130,6 +140,8 @@ func main() {
 	api.RegisterCommands(app, "api", []string{"a"})
 	node.RegisterCommands(app, "node", []string{"n"})
 	watchtower.RegisterCommands(app, "watchtower", []string{"w"})
+	observer.RegisterCommands(app, "observer", []string{"o"})
+	privaterelay.RegisterCommands(app, "private-relay", []string{"pr"})
 
 	// Get command being run
 	var commandName string
@@ -15,6 +15,7 @@ import (
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -282,6 +283,9 @@ func (t *checkSoloMigrations) scrubVacantMinipool(address common.Address, reason
 		return
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Get the gas limit
 	gasInfo, err := mp.EstimateVoteScrubGas(opts)
 	if err != nil {
@@ -17,6 +17,8 @@ import (
 	"github.com/Seb369888/smartnode/shared/services"
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/notify"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	rprewards "github.com/Seb369888/smartnode/shared/services/rewards"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
@@ -43,6 +45,7 @@ type submitRewardsTree struct {
 	rp               *rocketpool.RocketPool
 	ec               rocketpool.ExecutionClient
 	bc               beacon.Client
+	notifier         *notify.Notifier
 	lock             *sync.Mutex
 	isRunning        bool
 	generationPrefix string
@@ -73,6 +76,10 @@ func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger lo
 	if err != nil {
 		return nil, err
 	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	lock := &sync.Mutex{}
 	generator := &submitRewardsTree{
@@ -82,6 +89,7 @@ func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger lo
 		cfg:              cfg,
 		ec:               ec,
 		bc:               bc,
+		notifier:         notifier,
 		w:                w,
 		rp:               rp,
 		lock:             lock,
@@ -435,6 +443,9 @@ func (t *submitRewardsTree) submitRewardsSnapshot(index *big.Int, consensusBlock
 		return err
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Create the submission
 	submission := rewards.RewardSubmission{
 		RewardIndex:     index,
@@ -478,6 +489,7 @@ func (t *submitRewardsTree) submitRewardsSnapshot(index *big.Int, consensusBlock
 	if err != nil {
 		return err
 	}
+	t.notifier.Notify(notify.RewardsTreeSubmitted(index.Uint64(), hash))
 
 	// Return
 	return nil
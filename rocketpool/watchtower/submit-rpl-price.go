@@ -28,6 +28,7 @@ import (
 	"github.com/Seb369888/smartnode/shared/services/config"
 	"github.com/Seb369888/smartnode/shared/services/contracts"
 	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
 	"github.com/Seb369888/smartnode/shared/services/state"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	"github.com/Seb369888/smartnode/shared/utils/api"
@@ -630,6 +631,9 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice, effectiveR
 		return err
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	var hash common.Hash
 	if isAtlasDeployed {
 		// Get the gas limit
@@ -709,6 +713,9 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 		return fmt.Errorf("Failed getting transactor: %q", err)
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Construct the price messenger contract instance
 	parsed, err := abi.JSON(strings.NewReader(OptimismMessengerAbi))
 	if err != nil {
@@ -849,6 +856,9 @@ func (t *submitRplPrice) submitPolygonPrice() error {
 		return fmt.Errorf("Failed getting transactor: %q", err)
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Construct the price messenger contract instance
 	parsed, err := abi.JSON(strings.NewReader(PolygonMessengerAbi))
 	if err != nil {
@@ -989,6 +999,9 @@ func (t *submitRplPrice) submitArbitrumPrice() error {
 		return fmt.Errorf("Failed getting transactor: %q", err)
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Construct the price messenger contract instance
 	parsed, err := abi.JSON(strings.NewReader(ArbitrumMessengerAbi))
 	if err != nil {
@@ -1051,7 +1064,7 @@ func (t *submitRplPrice) submitArbitrumPrice() error {
 	if index == indexToSubmit {
 
 		// Get the current network recommended max fee
-		suggestedMaxFee, err := rpgas.GetHeadlessMaxFeeWei()
+		suggestedMaxFee, err := rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return fmt.Errorf("error getting recommended base fee from the network for Arbitrum price submission: %w", err)
 		}
@@ -1154,6 +1167,9 @@ func (t *submitRplPrice) submitZkSyncEraPrice() error {
 		return fmt.Errorf("Failed getting transactor: %q", err)
 	}
 
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
 	// Construct the price messenger contract instance
 	parsed, err := abi.JSON(strings.NewReader(zkSyncEraMessengerAbi))
 	if err != nil {
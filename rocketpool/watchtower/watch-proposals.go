@@ -0,0 +1,288 @@
+package watchtower
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/dao"
+	"github.com/Seb369888/poolsea-go/dao/trustednode"
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	rptypes "github.com/Seb369888/poolsea-go/types"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/rocketpool/api/odao"
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	rpgas "github.com/Seb369888/smartnode/shared/services/gas"
+	"github.com/Seb369888/smartnode/shared/services/notify"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	"github.com/Seb369888/smartnode/shared/utils/api"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// The proposal states and warnings that have already been reported, saved so they aren't reported again after a restart
+type proposalWatchState struct {
+	States map[uint64]rptypes.ProposalState `json:"states"`
+	Warned map[uint64]bool                  `json:"warned"`
+}
+
+// Watch proposals task
+type watchProposals struct {
+	c        *cli.Context
+	log      log.ColorLogger
+	errLog   log.ColorLogger
+	cfg      *config.RocketPoolConfig
+	w        *wallet.Wallet
+	rp       *rocketpool.RocketPool
+	notifier *notify.Notifier
+
+	// Settings
+	expiryWarningTime    time.Duration
+	autoExecute          bool
+	executeSupportedOnly bool
+	gasThreshold         float64
+
+	// The last seen state of each proposal, and the proposals that have already been warned about
+	statePath string
+	states    map[uint64]rptypes.ProposalState
+	warned    map[uint64]bool
+}
+
+// Create watch proposals task
+func newWatchProposals(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*watchProposals, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the settings
+	expiryWarningHours := cfg.Smartnode.ProposalExpiryWarningTime.Value.(uint64)
+	autoExecute := cfg.Smartnode.AutoExecuteProposals.Value.(bool)
+	gasThreshold := cfg.Smartnode.AutoExecuteGasThreshold.Value.(float64)
+	if autoExecute && gasThreshold == 0 {
+		logger.Println("Auto-execute gas threshold is 0, disabling automatic proposal execution.")
+		autoExecute = false
+	}
+
+	// Load the state from the last run
+	statePath := os.ExpandEnv(cfg.Smartnode.GetProposalWatchStatePath())
+	state := proposalWatchState{
+		States: map[uint64]rptypes.ProposalState{},
+		Warned: map[uint64]bool{},
+	}
+	bytes, err := os.ReadFile(statePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error reading proposal watch state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(bytes, &state); err != nil {
+			return nil, fmt.Errorf("error decoding proposal watch state: %w", err)
+		}
+	}
+
+	// Return task
+	return &watchProposals{
+		c:                    c,
+		log:                  logger,
+		errLog:               errorLogger,
+		cfg:                  cfg,
+		w:                    w,
+		rp:                   rp,
+		notifier:             notifier,
+		expiryWarningTime:    time.Duration(expiryWarningHours) * time.Hour,
+		autoExecute:          autoExecute,
+		executeSupportedOnly: cfg.Smartnode.AutoExecuteSupportedOnly.Value.(bool),
+		gasThreshold:         gasThreshold,
+		statePath:            statePath,
+		states:               state.States,
+		warned:               state.Warned,
+	}, nil
+
+}
+
+// Check the oracle DAO proposals for state changes
+func (t *watchProposals) run() error {
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Checking oracle DAO proposals...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the proposal states
+	states, err := odao.GetProposalStates(t.rp, odao.TNDAOProposalsName)
+	if err != nil {
+		return fmt.Errorf("error getting oracle DAO proposal states: %w", err)
+	}
+
+	proposalIds := make([]uint64, 0, len(states))
+	for proposalId := range states {
+		proposalIds = append(proposalIds, proposalId)
+	}
+	sort.Slice(proposalIds, func(i, j int) bool { return proposalIds[i] < proposalIds[j] })
+
+	// Process the proposals that are open or waiting to be executed
+	now := time.Now()
+	for _, proposalId := range proposalIds {
+		state := states[proposalId]
+		previousState, known := t.states[proposalId]
+		if state != rptypes.Active && state != rptypes.Succeeded {
+			t.states[proposalId] = state
+
+			// Warnings only matter while voting is open
+			delete(t.warned, proposalId)
+			continue
+		}
+
+		// Get the proposal details; the state is only recorded once it's been reported, so a failure here is retried next time
+		proposal, err := dao.GetProposalDetailsWithMember(t.rp, proposalId, nodeAccount.Address, nil)
+		if err != nil {
+			t.errLog.Println(fmt.Errorf("error getting details of proposal %d: %w", proposalId, err))
+			continue
+		}
+
+		switch state {
+		case rptypes.Active:
+			// Notify when voting opens
+			endTime := time.Unix(int64(proposal.EndTime), 0)
+			if !known || previousState != rptypes.Active {
+				t.log.Printlnf("Proposal %d is open for voting until %s: %s", proposalId, endTime.UTC().Format(time.RFC1123), proposal.Message)
+				t.notifier.Notify(notify.ProposalOpened(proposalId, proposal.Message, proposal.ProposerAddress, endTime))
+			}
+
+			// Warn once if the voting period is about to end and the node hasn't voted
+			if !proposal.MemberVoted && !t.warned[proposalId] && endTime.Sub(now) < t.expiryWarningTime {
+				t.log.Printlnf("Voting on proposal %d ends at %s and this node hasn't voted yet.", proposalId, endTime.UTC().Format(time.RFC1123))
+				t.notifier.Notify(notify.ProposalExpiring(proposalId, proposal.Message, endTime))
+				t.warned[proposalId] = true
+			}
+			t.states[proposalId] = state
+
+		case rptypes.Succeeded:
+			// Notify when the proposal passes
+			delete(t.warned, proposalId)
+			expiryTime := time.Unix(int64(proposal.ExpiryTime), 0)
+			if !known || previousState != rptypes.Succeeded {
+				t.log.Printlnf("Proposal %d passed with %.2f votes (%.2f required) and can be executed until %s.", proposalId, proposal.VotesFor, proposal.VotesRequired, expiryTime.UTC().Format(time.RFC1123))
+				t.notifier.Notify(notify.ProposalSucceeded(proposalId, proposal.Message, proposal.VotesFor, proposal.VotesRequired, expiryTime))
+			}
+			t.states[proposalId] = state
+
+			// Execute it if requested
+			if t.autoExecute && now.Before(expiryTime) {
+				if t.executeSupportedOnly && !(proposal.MemberVoted && proposal.MemberSupported) {
+					continue
+				}
+				if err := t.executeProposal(proposal); err != nil {
+					t.errLog.Println(fmt.Errorf("error executing proposal %d: %w", proposalId, err))
+				}
+			}
+		}
+	}
+
+	// Save what was reported
+	return t.saveState()
+
+}
+
+// Save the reported proposal states and warnings
+func (t *watchProposals) saveState() error {
+	bytes, err := json.Marshal(proposalWatchState{
+		States: t.states,
+		Warned: t.warned,
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding proposal watch state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.statePath), 0755); err != nil {
+		return fmt.Errorf("error creating proposal watch state directory: %w", err)
+	}
+	if err := os.WriteFile(t.statePath, bytes, 0644); err != nil {
+		return fmt.Errorf("error saving proposal watch state: %w", err)
+	}
+	return nil
+}
+
+// Execute a succeeded proposal
+func (t *watchProposals) executeProposal(proposal dao.ProposalDetails) error {
+
+	// Log
+	t.log.Printlnf("Executing proposal %d...", proposal.ID)
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Use the private transaction route if it's enabled for oDAO submissions
+	opts.Context = privatetx.WithCategory(opts.Context, privatetx.Category_OdaoSubmission)
+
+	// Get the gas limit
+	gasInfo, err := trustednode.EstimateExecuteProposalGas(t.rp, proposal.ID, opts)
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to execute the proposal: %w", err)
+	}
+
+	// Get the max fee and check it against the threshold
+	maxFee, err := rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
+	if err != nil {
+		return err
+	}
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, 0) {
+		return nil
+	}
+
+	// Set the gas settings
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = eth.GweiToWei(getWatchtowerPrioFee(t.cfg))
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	// Execute
+	hash, err := trustednode.ExecuteProposal(t.rp, proposal.ID, opts)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	t.log.Printlnf("Successfully executed proposal %d.", proposal.ID)
+	t.notifier.Notify(notify.ProposalExecuted(proposal.ID, proposal.Message, hash))
+	t.states[proposal.ID] = rptypes.Executed
+	return nil
+
+}
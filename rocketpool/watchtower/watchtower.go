@@ -44,6 +44,7 @@ const (
 	CancelBondsColor               = color.FgGreen
 	CheckSoloMigrationsColor       = color.FgCyan
 	UpdateColor                    = color.FgHiWhite
+	WatchProposalsColor            = color.FgHiBlue
 )
 
 // Register watchtower command
@@ -86,6 +87,10 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return err
+	}
 
 	// Initialize the metrics reporters
 	scrubCollector := collectors.NewScrubCollector()
@@ -150,6 +155,11 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error during solo migration check: %w", err)
 	}
 
+	watchProposals, err := newWatchProposals(c, log.NewColorLogger(WatchProposalsColor), errorLog)
+	if err != nil {
+		return fmt.Errorf("error during proposal watcher check: %w", err)
+	}
+
 	intervalDelta := maxTasksInterval - minTasksInterval
 	secondsDelta := intervalDelta.Seconds()
 
@@ -199,7 +209,7 @@ func run(c *cli.Context) error {
 			}
 
 			// Run the manual rewards tree generation
-			if err := generateRewardsTree.run(); err != nil {
+			if err := notifier.TrackTask("rewards tree generation", generateRewardsTree.run()); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
@@ -220,55 +230,61 @@ func run(c *cli.Context) error {
 				}
 
 				// Run the rewards tree submission check
-				if err := submitRewardsTree.run(isOnOdao, state, latestBlock.Slot, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("rewards tree submission", submitRewardsTree.run(isOnOdao, state, latestBlock.Slot, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the challenge check
-				if err := respondChallenges.run(isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("challenge response", respondChallenges.run(isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the price submission check
-				if err := submitRplPrice.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("RPL price submission", submitRplPrice.run(state, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the network balance submission check
-				if err := submitNetworkBalances.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("network balance submission", submitNetworkBalances.run(state, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the minipool dissolve check
-				if err := dissolveTimedOutMinipools.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("minipool dissolve", dissolveTimedOutMinipools.run(state, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the minipool scrub check
-				if err := submitScrubMinipools.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("scrub check", submitScrubMinipools.run(state, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the bond cancel check
-				if err := cancelBondReductions.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("bond reduction check", cancelBondReductions.run(state, isAtlasDeployedMasterFlag)); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
 
 				// Run the solo migration check
-				if err := checkSoloMigrations.run(state, isAtlasDeployedMasterFlag); err != nil {
+				if err := notifier.TrackTask("solo migration check", checkSoloMigrations.run(state, isAtlasDeployedMasterFlag)); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the proposal watcher
+				if err := notifier.TrackTask("proposal watcher", watchProposals.run()); err != nil {
 					errorLog.Println(err)
 				}
 				/*time.Sleep(taskCooldown)
 
 				// Run the fee recipient penalty check
-				if err := processPenalties.run(); err != nil {
+				if err := notifier.TrackTask("penalty processing", processPenalties.run()); err != nil {
 					errorLog.Println(err)
 				}*/
 				// DISABLED until MEV-Boost can support it
@@ -284,7 +300,7 @@ func run(c *cli.Context) error {
 				}
 
 				// Run the rewards tree submission check
-				if err := submitRewardsTree.run(isOnOdao, nil, latestBlock.Slot, isAtlasDeployed); err != nil {
+				if err := notifier.TrackTask("rewards tree submission", submitRewardsTree.run(isOnOdao, nil, latestBlock.Slot, isAtlasDeployed)); err != nil {
 					errorLog.Println(err)
 				}
 			}
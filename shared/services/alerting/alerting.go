@@ -0,0 +1,276 @@
+package alerting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/state"
+)
+
+// How bad an alert is
+type Severity string
+
+const (
+	Severity_Warning  Severity = "warning"
+	Severity_Critical Severity = "critical"
+)
+
+// Whether an alert has started or stopped
+type AlertStatus string
+
+const (
+	AlertStatus_Firing   AlertStatus = "firing"
+	AlertStatus_Resolved AlertStatus = "resolved"
+)
+
+// A condition raised by a rule
+type Alert struct {
+	// The rule that raised the alert
+	Rule string `json:"rule"`
+
+	// What the alert is about (a minipool or node address); a rule raises at most one alert per subject
+	Subject string `json:"subject"`
+
+	Severity Severity    `json:"severity"`
+	Status   AlertStatus `json:"status"`
+	Summary  string      `json:"summary"`
+
+	// When the alert started firing, and when it was resolved
+	FiredAt    time.Time `json:"firedAt"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+// The key used to deduplicate an alert
+func (a Alert) Key() string {
+	return a.Rule + "/" + a.Subject
+}
+
+// Everything a rule can look at when it's evaluated
+type RuleContext struct {
+	Cfg         *config.RocketPoolConfig
+	Rp          *rocketpool.RocketPool
+	Bc          beacon.Client
+	NodeAddress common.Address
+	State       *state.NetworkState
+
+	// The time of the block the state was taken at
+	Time time.Time
+}
+
+// A condition that's checked against each network state
+type Rule interface {
+	// The rule's name, used in the alerts it raises
+	GetName() string
+
+	// Get the alerts that are currently firing; only the Subject, Severity and Summary need to be set
+	Evaluate(ctx *RuleContext) ([]Alert, error)
+}
+
+// Somewhere alerts are sent
+type Sink interface {
+	// The sink's name, for logging
+	GetName() string
+
+	// Send an alert that started firing or was resolved
+	Send(alert Alert) error
+}
+
+// Runs rules against each network state and sends their alerts to the sinks, deduplicated by subject
+type Evaluator struct {
+	rules     []Rule
+	sinks     []Sink
+	statePath string
+	active    map[string]Alert
+
+	// The changes each sink hasn't accepted yet, by sink name and alert key
+	pending map[string]map[string]Alert
+
+	lock sync.Mutex
+}
+
+// The evaluator's state, saved between runs
+type evaluatorState struct {
+	Active  map[string]Alert            `json:"active"`
+	Pending map[string]map[string]Alert `json:"pending"`
+}
+
+// Create an evaluator, loading the alerts that were active and the changes that weren't delivered when it last ran from its state file
+func NewEvaluator(rules []Rule, sinks []Sink, statePath string) (*Evaluator, error) {
+	state := evaluatorState{}
+	bytes, err := os.ReadFile(statePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error reading alert state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(bytes, &state); err != nil {
+			return nil, fmt.Errorf("error decoding alert state: %w", err)
+		}
+	}
+	if state.Active == nil {
+		state.Active = map[string]Alert{}
+	}
+
+	// Only keep the undelivered changes of the sinks that are still set up
+	pending := map[string]map[string]Alert{}
+	for _, sink := range sinks {
+		name := sink.GetName()
+		pending[name] = state.Pending[name]
+		if pending[name] == nil {
+			pending[name] = map[string]Alert{}
+		}
+	}
+	return &Evaluator{
+		rules:     rules,
+		sinks:     sinks,
+		statePath: statePath,
+		active:    state.Active,
+		pending:   pending,
+	}, nil
+}
+
+// Get the alerts that are currently firing, sorted by key
+func (e *Evaluator) GetActiveAlerts() []Alert {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	alerts := make([]Alert, 0, len(e.active))
+	for _, alert := range e.active {
+		alerts = append(alerts, alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].Key() < alerts[j].Key()
+	})
+	return alerts
+}
+
+// Evaluate every rule, send the alerts that started firing or were resolved since the last run and return them.
+// A rule that fails keeps its active alerts as they are; its error is returned with the others.
+// Each sink keeps the changes it didn't accept and is sent them again on the next run.
+func (e *Evaluator) Run(ctx *RuleContext) ([]Alert, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	changes := []Alert{}
+	ruleErrs := []error{}
+	next := map[string]Alert{}
+	for _, rule := range e.rules {
+		name := rule.GetName()
+		alerts, err := rule.Evaluate(ctx)
+		if err != nil {
+			ruleErrs = append(ruleErrs, fmt.Errorf("error evaluating alert rule %s: %w", name, err))
+			for key, alert := range e.active {
+				if alert.Rule == name {
+					next[key] = alert
+				}
+			}
+			continue
+		}
+
+		// Keep the alerts that were already firing, and raise the new ones
+		for _, alert := range alerts {
+			alert.Rule = name
+			alert.Status = AlertStatus_Firing
+			key := alert.Key()
+			if existing, exists := e.active[key]; exists {
+				alert.FiredAt = existing.FiredAt
+			} else {
+				alert.FiredAt = ctx.Time
+				changes = append(changes, alert)
+			}
+			next[key] = alert
+		}
+	}
+
+	// Resolve the alerts that stopped firing
+	for key, alert := range e.active {
+		if _, exists := next[key]; !exists {
+			alert.Status = AlertStatus_Resolved
+			alert.ResolvedAt = ctx.Time
+			changes = append(changes, alert)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Key() < changes[j].Key()
+	})
+	e.active = next
+
+	// Queue the changes for every sink; a newer change replaces one the sink hasn't accepted yet,
+	// and an alert that was resolved before the sink accepted it firing isn't sent to it at all
+	for _, alert := range changes {
+		key := alert.Key()
+		for _, pending := range e.pending {
+			if queued, exists := pending[key]; exists && queued.Status == AlertStatus_Firing && alert.Status == AlertStatus_Resolved {
+				delete(pending, key)
+				continue
+			}
+			pending[key] = alert
+		}
+	}
+
+	// Send each sink the changes it's missing, clearing them only once it accepts them
+	sendErrs := []error{}
+	for _, sink := range e.sinks {
+		pending := e.pending[sink.GetName()]
+		keys := make([]string, 0, len(pending))
+		for key := range pending {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := sink.Send(pending[key]); err != nil {
+				sendErrs = append(sendErrs, fmt.Errorf("error sending alert %s to %s: %w", key, sink.GetName(), err))
+				continue
+			}
+			delete(pending, key)
+		}
+	}
+
+	// Save the state so a restart doesn't send the active alerts again or lose the undelivered ones
+	if err := e.save(); err != nil {
+		return changes, err
+	}
+	return changes, joinErrors(append(ruleErrs, sendErrs...))
+}
+
+// Combine several errors into one, or nil if there aren't any
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// Save the active alerts and undelivered changes to the state file
+func (e *Evaluator) save() error {
+	bytes, err := json.Marshal(evaluatorState{
+		Active:  e.active,
+		Pending: e.pending,
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding alert state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(e.statePath), 0755); err != nil {
+		return fmt.Errorf("error creating alert state directory: %w", err)
+	}
+	if err := os.WriteFile(e.statePath, bytes, 0644); err != nil {
+		return fmt.Errorf("error saving alert state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,379 @@
+package alerting
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	rpstate "github.com/Seb369888/poolsea-go/utils/state"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	rprewards "github.com/Seb369888/smartnode/shared/services/rewards"
+	rpsvc "github.com/Seb369888/smartnode/shared/services/rocketpool"
+	rputils "github.com/Seb369888/smartnode/shared/utils/rp"
+)
+
+// Rule names
+const (
+	RuleName_ValidatorOffline     string = "validatorOffline"
+	RuleName_BalanceFalling       string = "balanceFalling"
+	RuleName_PrelaunchStuck       string = "prelaunchStuck"
+	RuleName_CollateralBelowMin   string = "collateralBelowMinimum"
+	RuleName_FeeRecipientMismatch string = "feeRecipientMismatch"
+	RuleName_RewardsIntervalRoll  string = "unclaimedRewardsIntervalRoll"
+)
+
+// Rule settings
+const (
+	balanceFallingEpochs     uint64 = 225 // About a day
+	prelaunchGracePeriod            = time.Hour
+	prelaunchDissolveWarning        = 12 * time.Hour
+	rewardsRollWarning              = 24 * time.Hour
+)
+
+// How far above the deposit balance a validator's balance can be right after a withdrawal sweep, as a fraction of the deposit
+// (the same share that 0.01 ETH is of 32 ETH)
+const withdrawalSweepToleranceDivisor uint64 = 3200
+
+// Get the total amount deposited into a minipool's validator by the node and the pool stakers, in gwei
+func getDepositBalanceGwei(mpd *rpstate.NativeMinipoolDetails) uint64 {
+	balance := new(big.Int).Add(mpd.NodeDepositBalance, mpd.UserDepositBalance)
+	return balance.Quo(balance, big.NewInt(1e9)).Uint64()
+}
+
+// Get the default set of rules
+func NewDefaultRules(offlineEpochs uint64) []Rule {
+	history := newBalanceHistory(offlineEpochs, balanceFallingEpochs)
+	return []Rule{
+		&validatorOfflineRule{history: history, epochs: offlineEpochs},
+		&balanceFallingRule{history: history, epochs: balanceFallingEpochs},
+		&prelaunchStuckRule{},
+		&collateralBelowMinimumRule{},
+		&feeRecipientMismatchRule{},
+		&rewardsIntervalRollRule{nodeExists: map[uint64]bool{}},
+	}
+}
+
+// A validator's balance at an epoch
+type balanceSample struct {
+	epoch   uint64
+	balance uint64
+}
+
+// The recent balances of the node's validators, shared by the balance rules
+type balanceHistory struct {
+	maxEpochs uint64
+	lastSlot  uint64
+	samples   map[types.ValidatorPubkey][]balanceSample
+}
+
+// Create a balance history that keeps enough samples for the longest of the given lookbacks
+func newBalanceHistory(lookbacks ...uint64) *balanceHistory {
+	maxEpochs := uint64(0)
+	for _, lookback := range lookbacks {
+		if lookback > maxEpochs {
+			maxEpochs = lookback
+		}
+	}
+	return &balanceHistory{
+		maxEpochs: maxEpochs,
+		samples:   map[types.ValidatorPubkey][]balanceSample{},
+	}
+}
+
+// Record the balances of the node's active validators in a state; each state is only recorded once
+func (h *balanceHistory) update(ctx *RuleContext) {
+	if ctx.State.BeaconSlotNumber == h.lastSlot {
+		return
+	}
+	h.lastSlot = ctx.State.BeaconSlotNumber
+	epoch := ctx.State.BeaconSlotNumber / ctx.State.BeaconConfig.SlotsPerEpoch
+
+	seen := map[types.ValidatorPubkey]bool{}
+	for _, mpd := range ctx.State.MinipoolDetailsByNode[ctx.NodeAddress] {
+		status := ctx.State.ValidatorDetails[mpd.Pubkey]
+		if !status.Exists || status.Status != beacon.ValidatorState_ActiveOngoing {
+			continue
+		}
+		seen[mpd.Pubkey] = true
+		samples := h.samples[mpd.Pubkey]
+		if len(samples) > 0 {
+			last := samples[len(samples)-1]
+			if last.epoch == epoch {
+				continue
+			}
+
+			// A withdrawal sweep takes the balance back down to the minipool's deposit balance, so start over after one
+			depositBalance := getDepositBalanceGwei(mpd)
+			if last.balance > depositBalance && status.Balance < last.balance &&
+				status.Balance >= depositBalance && status.Balance-depositBalance < depositBalance/withdrawalSweepToleranceDivisor {
+				samples = nil
+			}
+		}
+		samples = append(samples, balanceSample{epoch: epoch, balance: status.Balance})
+
+		// Prune the samples that are too old to be used
+		for len(samples) > 1 && samples[1].epoch+h.maxEpochs <= epoch {
+			samples = samples[1:]
+		}
+		h.samples[mpd.Pubkey] = samples
+	}
+
+	// Forget the validators that are no longer active
+	for pubkey := range h.samples {
+		if !seen[pubkey] {
+			delete(h.samples, pubkey)
+		}
+	}
+}
+
+// Get a validator's balance from at least the given number of epochs ago, and the samples since then
+func (h *balanceHistory) since(pubkey types.ValidatorPubkey, epochs uint64) ([]balanceSample, bool) {
+	samples := h.samples[pubkey]
+	if len(samples) < 2 {
+		return nil, false
+	}
+	latest := samples[len(samples)-1].epoch
+	for i := len(samples) - 1; i >= 0; i-- {
+		if samples[i].epoch+epochs <= latest {
+			return samples[i:], true
+		}
+	}
+	return nil, false
+}
+
+// Get the address of the minipool a validator belongs to
+func getMinipoolAddress(ctx *RuleContext, pubkey types.ValidatorPubkey) common.Address {
+	for _, mpd := range ctx.State.MinipoolDetailsByNode[ctx.NodeAddress] {
+		if mpd.Pubkey == pubkey {
+			return mpd.MinipoolAddress
+		}
+	}
+	return common.Address{}
+}
+
+// Fires when an active validator's balance hasn't gone up for a number of epochs, which means it isn't attesting
+type validatorOfflineRule struct {
+	history *balanceHistory
+	epochs  uint64
+}
+
+func (r *validatorOfflineRule) GetName() string {
+	return RuleName_ValidatorOffline
+}
+
+func (r *validatorOfflineRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	r.history.update(ctx)
+	alerts := []Alert{}
+	for pubkey := range r.history.samples {
+		samples, ok := r.history.since(pubkey, r.epochs)
+		if !ok {
+			continue
+		}
+		increased := false
+		for i := 1; i < len(samples); i++ {
+			if samples[i].balance > samples[i-1].balance {
+				increased = true
+				break
+			}
+		}
+		if increased {
+			continue
+		}
+		minipoolAddress := getMinipoolAddress(ctx, pubkey)
+		alerts = append(alerts, Alert{
+			Subject:  minipoolAddress.Hex(),
+			Severity: Severity_Critical,
+			Summary:  fmt.Sprintf("The validator for minipool %s (%s) hasn't earned any rewards since epoch %d; it appears to be offline.", minipoolAddress.Hex(), pubkey.Hex(), samples[0].epoch),
+		})
+	}
+	return alerts, nil
+}
+
+// Fires when an active validator's balance is lower than it was about a day ago
+type balanceFallingRule struct {
+	history *balanceHistory
+	epochs  uint64
+}
+
+func (r *balanceFallingRule) GetName() string {
+	return RuleName_BalanceFalling
+}
+
+func (r *balanceFallingRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	r.history.update(ctx)
+	alerts := []Alert{}
+	for pubkey := range r.history.samples {
+		samples, ok := r.history.since(pubkey, r.epochs)
+		if !ok {
+			continue
+		}
+		first := samples[0]
+		last := samples[len(samples)-1]
+		if last.balance >= first.balance {
+			continue
+		}
+		minipoolAddress := getMinipoolAddress(ctx, pubkey)
+		lost := eth.GweiToWei(float64(first.balance - last.balance))
+		alerts = append(alerts, Alert{
+			Subject:  minipoolAddress.Hex(),
+			Severity: Severity_Warning,
+			Summary:  fmt.Sprintf("The validator for minipool %s (%s) has lost %.6f ETH since epoch %d.", minipoolAddress.Hex(), pubkey.Hex(), eth.WeiToEth(lost), first.epoch),
+		})
+	}
+	return alerts, nil
+}
+
+// Fires when a minipool's scrub window has been over for a while but it still hasn't been staked
+type prelaunchStuckRule struct{}
+
+func (r *prelaunchStuckRule) GetName() string {
+	return RuleName_PrelaunchStuck
+}
+
+func (r *prelaunchStuckRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	alerts := []Alert{}
+	launchTimeout := time.Duration(0)
+	if ctx.State.NetworkDetails.MinipoolLaunchTimeout != nil {
+		launchTimeout = time.Duration(ctx.State.NetworkDetails.MinipoolLaunchTimeout.Uint64()) * time.Second
+	}
+	for _, mps := range lifecycle.GetNodeMinipoolsInStage(ctx.State, ctx.NodeAddress, lifecycle.Stage_Prelaunch) {
+		// Vacant minipools wait for the promotion scrub period instead of the normal one
+		scrubPeriod := ctx.State.NetworkDetails.ScrubPeriod
+		if mps.Details.IsVacant {
+			scrubPeriod = ctx.State.NetworkDetails.PromotionScrubPeriod
+		}
+		statusTime := time.Unix(mps.Details.StatusTime.Int64(), 0)
+		if ctx.Time.Sub(statusTime.Add(scrubPeriod)) < prelaunchGracePeriod {
+			continue
+		}
+
+		alert := Alert{
+			Subject:  mps.Address.Hex(),
+			Severity: Severity_Warning,
+			Summary:  fmt.Sprintf("Minipool %s finished its scrub window at %s but still hasn't been staked.", mps.Address.Hex(), statusTime.Add(scrubPeriod).Format(time.RFC822)),
+		}
+		if launchTimeout > 0 && !mps.Details.IsVacant {
+			dissolveTime := statusTime.Add(launchTimeout)
+			if ctx.Time.Add(prelaunchDissolveWarning).After(dissolveTime) {
+				alert.Severity = Severity_Critical
+			}
+			alert.Summary += fmt.Sprintf(" It can be dissolved after %s.", dissolveTime.Format(time.RFC822))
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+// Fires when the node's RPL stake is below the minimum it needs to earn RPL rewards
+type collateralBelowMinimumRule struct{}
+
+func (r *collateralBelowMinimumRule) GetName() string {
+	return RuleName_CollateralBelowMin
+}
+
+func (r *collateralBelowMinimumRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	node, exists := ctx.State.NodeDetailsByAddress[ctx.NodeAddress]
+	if !exists || node.MinipoolCount == nil || node.MinipoolCount.Sign() == 0 {
+		return []Alert{}, nil
+	}
+	if node.RplStake == nil || node.MinimumRPLStake == nil || node.RplStake.Cmp(node.MinimumRPLStake) >= 0 {
+		return []Alert{}, nil
+	}
+	return []Alert{{
+		Subject:  ctx.NodeAddress.Hex(),
+		Severity: Severity_Warning,
+		Summary:  fmt.Sprintf("Your node's RPL stake of %.6f RPL is below the minimum of %.6f RPL, so it won't earn RPL rewards.", eth.WeiToEth(node.RplStake), eth.WeiToEth(node.MinimumRPLStake)),
+	}}, nil
+}
+
+// Fires when the validator client's fee recipient isn't the one the node should be using
+type feeRecipientMismatchRule struct{}
+
+func (r *feeRecipientMismatchRule) GetName() string {
+	return RuleName_FeeRecipientMismatch
+}
+
+func (r *feeRecipientMismatchRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	if !ctx.State.IsAtlasDeployed {
+		return []Alert{}, nil
+	}
+	info, err := rputils.GetFeeRecipientInfo_Atlas(ctx.Rp, ctx.Bc, ctx.NodeAddress, ctx.State)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee recipient info: %w", err)
+	}
+	correctFeeRecipient := info.FeeDistributorAddress
+	if info.IsInSmoothingPool || info.IsInOptOutCooldown {
+		correctFeeRecipient = info.SmoothingPoolAddress
+	}
+	fileExists, correctAddress, err := rpsvc.CheckFeeRecipientFile(correctFeeRecipient, ctx.Cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error checking fee recipient file: %w", err)
+	}
+	if fileExists && correctAddress {
+		return []Alert{}, nil
+	}
+	return []Alert{{
+		Subject:  ctx.NodeAddress.Hex(),
+		Severity: Severity_Critical,
+		Summary:  fmt.Sprintf("Your validator client isn't using the correct fee recipient of %s; any blocks it proposes may be penalized.", correctFeeRecipient.Hex()),
+	}}, nil
+}
+
+// Fires when the current rewards interval is about to end and the node still has rewards from earlier intervals to claim
+type rewardsIntervalRollRule struct {
+	// Whether the node earned rewards in each interval with a valid tree file; this doesn't change, so it's cached
+	nodeExists map[uint64]bool
+}
+
+func (r *rewardsIntervalRollRule) GetName() string {
+	return RuleName_RewardsIntervalRoll
+}
+
+func (r *rewardsIntervalRollRule) Evaluate(ctx *RuleContext) ([]Alert, error) {
+	if !ctx.State.IsAtlasDeployed {
+		return []Alert{}, nil
+	}
+	intervalEnd := ctx.State.NetworkDetails.IntervalStart.Add(ctx.State.NetworkDetails.IntervalDuration)
+	if intervalEnd.Sub(ctx.Time) > rewardsRollWarning {
+		return []Alert{}, nil
+	}
+	unclaimed, _, err := rprewards.GetClaimStatus(ctx.Rp, ctx.NodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rewards claim status: %w", err)
+	}
+
+	// Only count the intervals the node earned rewards in
+	claimable := 0
+	for _, interval := range unclaimed {
+		nodeExists, exists := r.nodeExists[interval]
+		if !exists {
+			intervalInfo, err := rprewards.GetIntervalInfo(ctx.Rp, ctx.Cfg, ctx.NodeAddress, interval)
+			if err != nil {
+				return nil, fmt.Errorf("error getting info for interval %d: %w", interval, err)
+			}
+			if !intervalInfo.TreeFileExists || !intervalInfo.MerkleRootValid {
+				continue
+			}
+			nodeExists = intervalInfo.NodeExists
+			r.nodeExists[interval] = nodeExists
+		}
+		if nodeExists {
+			claimable++
+		}
+	}
+	if claimable == 0 {
+		return []Alert{}, nil
+	}
+
+	// Key the alert by the interval that's ending, so it fires once per interval
+	return []Alert{{
+		Subject:  fmt.Sprintf("%s/%d", ctx.NodeAddress.Hex(), ctx.State.NetworkDetails.RewardIndex),
+		Severity: Severity_Warning,
+		Summary:  fmt.Sprintf("Rewards interval %d ends at %s and your node still has unclaimed rewards from %d earlier interval(s).", ctx.State.NetworkDetails.RewardIndex, intervalEnd.Format(time.RFC822), claimable),
+	}}, nil
+}
@@ -0,0 +1,115 @@
+package alerting
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Seb369888/smartnode/shared/services/notify"
+)
+
+// Get the one-line description of an alert used by the text-based sinks
+func getAlertTitle(alert Alert) string {
+	if alert.Status == AlertStatus_Resolved {
+		return fmt.Sprintf("[RESOLVED] %s", alert.Rule)
+	}
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(string(alert.Severity)), alert.Rule)
+}
+
+// Sends alerts to the daemon's notification targets
+type NotifierSink struct {
+	notifier *notify.Notifier
+}
+
+// Create a sink that sends alerts through a notifier
+func NewNotifierSink(notifier *notify.Notifier) *NotifierSink {
+	return &NotifierSink{
+		notifier: notifier,
+	}
+}
+
+func (s *NotifierSink) GetName() string {
+	return "notifications"
+}
+
+// The notifier delivers in the background and logs its own errors, so an alert is accepted once it's queued
+func (s *NotifierSink) Send(alert Alert) error {
+	if alert.Status == AlertStatus_Resolved {
+		s.notifier.Notify(notify.AlertResolved(alert.Rule, alert.Subject, alert.Summary, alert.FiredAt))
+	} else {
+		s.notifier.Notify(notify.AlertFiring(alert.Rule, alert.Subject, string(alert.Severity), alert.Summary))
+	}
+	return nil
+}
+
+// Sends alerts as emails through an SMTP relay that doesn't need authentication, such as a local MTA
+type SmtpSink struct {
+	address string
+	from    string
+	to      []string
+}
+
+// Create a sink that emails alerts through an SMTP relay
+func NewSmtpSink(address string, from string, to []string) *SmtpSink {
+	return &SmtpSink{
+		address: address,
+		from:    from,
+		to:      to,
+	}
+}
+
+func (s *SmtpSink) GetName() string {
+	return "smtp"
+}
+
+func (s *SmtpSink) Send(alert Alert) error {
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", s.from)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&message, "Subject: Poolsea alert: %s\r\n", getAlertTitle(alert))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&message, "%s\r\n\r\n", alert.Summary)
+	fmt.Fprintf(&message, "Fired at: %s\r\n", alert.FiredAt.Format(time.RFC1123Z))
+	if alert.Status == AlertStatus_Resolved {
+		fmt.Fprintf(&message, "Resolved at: %s\r\n", alert.ResolvedAt.Format(time.RFC1123Z))
+	}
+	return smtp.SendMail(s.address, nil, s.from, s.to, []byte(message.String()))
+}
+
+// Appends alerts to a local file, one JSON alert per line
+type FileSink struct {
+	path string
+	lock sync.Mutex
+}
+
+// Create a sink that writes alerts to a file
+func NewFileSink(path string) *FileSink {
+	return &FileSink{
+		path: path,
+	}
+}
+
+func (s *FileSink) GetName() string {
+	return "file"
+}
+
+func (s *FileSink) Send(alert Alert) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("error creating alert log directory: %w", err)
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening alert log: %w", err)
+	}
+	defer file.Close()
+	return json.NewEncoder(file).Encode(alert)
+}
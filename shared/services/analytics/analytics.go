@@ -0,0 +1,291 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Config
+const (
+	DefaultFileMode      = 0644
+	DefaultDirectoryMode = 0755
+	openTimeout          = 10 * time.Second
+)
+
+// The bucket holding the snapshots, keyed by their big-endian unix timestamp
+var snapshotBucket = []byte("snapshots")
+
+// The bucket holding the rewards the node earned in each interval, keyed by the big-endian interval index
+var rewardsBucket = []byte("rewards")
+
+// Returned when the analytics database hasn't been created yet
+var ErrNoData = errors.New("no analytics have been recorded yet; they are recorded by the node daemon after each network state update")
+
+// A record of the node and its minipools at one point in time. Amounts are in ETH (or RPL) rather than wei to keep the records compact.
+type Snapshot struct {
+	Time      time.Time        `json:"time"`
+	Block     uint64           `json:"block"`
+	Node      NodeRecord       `json:"node"`
+	Minipools []MinipoolRecord `json:"minipools,omitempty"`
+}
+
+// The node's stake and balances
+type NodeRecord struct {
+	RplStake          float64 `json:"rplStake"`
+	EffectiveRplStake float64 `json:"effectiveRplStake"`
+	CollateralRatio   float64 `json:"collateralRatio"`
+	RplPrice          float64 `json:"rplPrice"`
+	RethRate          float64 `json:"rethRate"`
+	EthBalance        float64 `json:"ethBalance"`
+	RplBalance        float64 `json:"rplBalance"`
+	MinipoolCount     uint64  `json:"minipoolCount"`
+}
+
+// A minipool's deposits and balances
+type MinipoolRecord struct {
+	Address          common.Address `json:"address"`
+	Status           string         `json:"status"`
+	NodeDeposit      float64        `json:"nodeDeposit"`
+	UserDeposit      float64        `json:"userDeposit"`
+	BeaconBalance    float64        `json:"beaconBalance"`
+	ExecutionBalance float64        `json:"executionBalance"`
+}
+
+// The rewards the node earned in a rewards interval
+type RewardsRecord struct {
+	Interval         uint64    `json:"interval"`
+	Start            time.Time `json:"start"`
+	End              time.Time `json:"end"`
+	CollateralRpl    float64   `json:"collateralRpl"`
+	OracleDaoRpl     float64   `json:"oracleDaoRpl"`
+	SmoothingPoolEth float64   `json:"smoothingPoolEth"`
+}
+
+// The performance of a minipool over a period
+type MinipoolPerformance struct {
+	Address      common.Address `json:"address"`
+	Start        time.Time      `json:"start"`
+	End          time.Time      `json:"end"`
+	StartBalance float64        `json:"startBalance"`
+	EndBalance   float64        `json:"endBalance"`
+	Deposit      float64        `json:"deposit"`
+	Apr          float64        `json:"apr"`
+}
+
+// The local time-series store
+type Store struct {
+	db *bolt.DB
+}
+
+// Open the store, creating it if necessary. Read-only stores can be opened while another process has it open for reading, but not while it's being written to.
+func Open(path string, readOnly bool) (*Store, error) {
+	if readOnly {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, ErrNoData
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), DefaultDirectoryMode); err != nil {
+			return nil, fmt.Errorf("error creating analytics folder: %w", err)
+		}
+	}
+
+	db, err := bolt.Open(path, DefaultFileMode, &bolt.Options{Timeout: openTimeout, ReadOnly: readOnly})
+	if err != nil {
+		return nil, fmt.Errorf("error opening analytics database %s: %w", path, err)
+	}
+	if !readOnly {
+		err = db.Update(func(tx *bolt.Tx) error {
+			for _, bucket := range [][]byte{snapshotBucket, rewardsBucket} {
+				if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("error initializing analytics database: %w", err)
+		}
+	}
+	return &Store{db: db}, nil
+}
+
+// Close the store
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Add a snapshot, replacing any other snapshot taken in the same second
+func (s *Store) AddSnapshot(snapshot Snapshot) error {
+	value, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("error serializing analytics snapshot: %w", err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(snapshotBucket).Put(getKey(snapshot.Time), value)
+	})
+}
+
+// Add the rewards the node earned in an interval, replacing any that were already recorded for it
+func (s *Store) AddRewards(record RewardsRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error serializing rewards record: %w", err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(rewardsBucket).Put(getIntervalKey(record.Interval), value)
+	})
+}
+
+// Check if the rewards for an interval have been recorded
+func (s *Store) HasRewards(interval uint64) (bool, error) {
+	exists := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rewardsBucket)
+		exists = bucket != nil && bucket.Get(getIntervalKey(interval)) != nil
+		return nil
+	})
+	return exists, err
+}
+
+// Get the rewards for the intervals that ended after the provided time, oldest first
+func (s *Store) GetRewards(since time.Time) ([]RewardsRecord, error) {
+	records := []RewardsRecord{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rewardsBucket)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(key []byte, value []byte) error {
+			var record RewardsRecord
+			if err := json.Unmarshal(value, &record); err != nil {
+				return fmt.Errorf("error deserializing rewards record for interval %d: %w", binary.BigEndian.Uint64(key), err)
+			}
+			if record.End.After(since) {
+				records = append(records, record)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Get the snapshots taken since the provided time, keeping at most one per interval (0 keeps all of them)
+func (s *Store) GetSnapshots(since time.Time, interval time.Duration) ([]Snapshot, error) {
+	snapshots := []Snapshot{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(snapshotBucket)
+		if bucket == nil {
+			return nil
+		}
+		var next time.Time
+		cursor := bucket.Cursor()
+		for key, value := cursor.Seek(getKey(since)); key != nil; key, value = cursor.Next() {
+			if interval > 0 && getTime(key).Before(next) {
+				continue
+			}
+			var snapshot Snapshot
+			if err := json.Unmarshal(value, &snapshot); err != nil {
+				return fmt.Errorf("error deserializing analytics snapshot from %s: %w", getTime(key), err)
+			}
+			snapshots = append(snapshots, snapshot)
+			next = getTime(key).Add(interval)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// Delete the snapshots taken before the provided time, returning how many were deleted
+func (s *Store) Prune(before time.Time) (int, error) {
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		// Collect the keys first, since deleting while iterating skips entries
+		bucket := tx.Bucket(snapshotBucket)
+		end := getKey(before)
+		keys := [][]byte{}
+		cursor := bucket.Cursor()
+		for key, _ := cursor.First(); key != nil && bytes.Compare(key, end) < 0; key, _ = cursor.Next() {
+			keys = append(keys, append([]byte{}, key...))
+		}
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		count = len(keys)
+		return nil
+	})
+	return count, err
+}
+
+// Get the performance of each minipool that was staking across the provided snapshots
+func GetMinipoolPerformance(snapshots []Snapshot) []MinipoolPerformance {
+	performance := []MinipoolPerformance{}
+	indices := map[common.Address]int{}
+	for _, snapshot := range snapshots {
+		for _, mp := range snapshot.Minipools {
+			if mp.Status != types.Staking.String() {
+				continue
+			}
+			balance := mp.BeaconBalance + mp.ExecutionBalance
+			index, exists := indices[mp.Address]
+			if !exists {
+				indices[mp.Address] = len(performance)
+				performance = append(performance, MinipoolPerformance{
+					Address:      mp.Address,
+					Start:        snapshot.Time,
+					StartBalance: balance,
+				})
+				index = len(performance) - 1
+			}
+			performance[index].End = snapshot.Time
+			performance[index].EndBalance = balance
+			performance[index].Deposit = mp.NodeDeposit + mp.UserDeposit
+		}
+	}
+
+	// Annualize the change in balance
+	for i, mp := range performance {
+		years := mp.End.Sub(mp.Start).Hours() / 24 / 365
+		if years > 0 && mp.Deposit > 0 {
+			performance[i].Apr = (mp.EndBalance - mp.StartBalance) / mp.Deposit / years * 100
+		}
+	}
+	return performance
+}
+
+// Get the key for a snapshot time
+func getKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.Unix()))
+	return key
+}
+
+// Get the key for a rewards interval
+func getIntervalKey(interval uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, interval)
+	return key
+}
+
+// Get the snapshot time from a key
+func getTime(key []byte) time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint64(key)), 0)
+}
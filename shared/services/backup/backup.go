@@ -0,0 +1,544 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/Seb369888/smartnode/shared/services/config"
+)
+
+// Config
+const (
+	FileExtension        string = ".rpbak"
+	FilenamePrefix       string = "smartnode-backup-"
+	FilenameTimeFormat   string = "20060102-150405"
+	SettingsEntryName    string = "user-settings.yml"
+	DataFolderEntryName  string = "data"
+	MinPassphraseLength  int    = 12
+	DefaultFileMode             = 0600
+	DefaultDirectoryMode        = 0700
+
+	tempSuffix string = ".tmp"
+
+	magic      string = "RPBACKUP2"
+	saltLength int    = 32
+	chunkSize  int    = 64 * 1024
+	scryptN    int    = 1 << 17
+	scryptR    int    = 8
+	scryptP    int    = 1
+	keyLength  int    = 32
+
+	// The chunk counter and final-chunk flag take up the end of each chunk's nonce
+	noncePrefixOverhead int = 5
+)
+
+// The files and folders (relative to the data folder) that are included in a backup
+var dataEntries = []string{
+	"wallet",
+	"password",
+	"custom-keys",
+	"custom-key-passwords",
+	filepath.Join("validators", config.FeeRecipientFilename),
+	filepath.Join("validators", config.NativeFeeRecipientFilename),
+	filepath.Join(config.WatchtowerFolder, config.WatchtowerStateFile),
+	config.RewardsTreesFolder,
+}
+
+// Get the filename for a new backup created at the provided time
+func GetFilename(timestamp time.Time) string {
+	return FilenamePrefix + timestamp.UTC().Format(FilenameTimeFormat) + FileExtension
+}
+
+// Create an encrypted backup of the node's settings file and the relevant contents of its data folder, saving it to the provided path.
+// The archive is streamed to a temporary file next to the path and only moved into place once it's complete.
+func SaveBackup(backupPath string, settingsPath string, dataPath string, passphrase string) ([]string, error) {
+
+	tempPath := backupPath + tempSuffix
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, DefaultFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup file [%s]: %w", tempPath, err)
+	}
+	entries, err := CreateBackup(file, settingsPath, dataPath, passphrase)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempPath, backupPath)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return nil, err
+	}
+	return entries, nil
+
+}
+
+// Write an encrypted backup archive of the node's settings file and the relevant contents of its data folder
+func CreateBackup(writer io.Writer, settingsPath string, dataPath string, passphrase string) ([]string, error) {
+
+	// Check the passphrase
+	if len(passphrase) < MinPassphraseLength {
+		return nil, fmt.Errorf("the backup passphrase must be at least %d characters long", MinPassphraseLength)
+	}
+
+	// Build the archive, encrypting it as it's written
+	encryptWriter, err := newEncryptWriter(writer, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	gzipWriter := gzip.NewWriter(encryptWriter)
+	tarWriter := tar.NewWriter(gzipWriter)
+	entries := []string{}
+
+	// Add the settings file
+	added, err := addToArchive(tarWriter, settingsPath, SettingsEntryName)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, added...)
+
+	// Add the data folder entries
+	for _, entry := range dataEntries {
+		added, err := addToArchive(tarWriter, filepath.Join(dataPath, entry), path.Join(DataFolderEntryName, filepath.ToSlash(entry)))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, added...)
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error finalizing backup archive: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing backup archive: %w", err)
+	}
+	if err := encryptWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error encrypting backup archive: %w", err)
+	}
+	return entries, nil
+
+}
+
+// Decrypt a backup archive and get the names of the files it contains, without restoring anything
+func ListBackup(backup io.Reader, passphrase string) ([]string, error) {
+
+	archive, err := newDecryptReader(backup, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []string{}
+	err = readArchive(archive, func(header *tar.Header, contents io.Reader) error {
+		if header.Typeflag == tar.TypeReg {
+			entries = append(entries, header.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+
+}
+
+// Decrypt a backup archive and restore its contents to the provided settings file and data folder
+func RestoreBackup(backup io.Reader, passphrase string, settingsPath string, dataPath string) ([]string, error) {
+
+	archive, err := newDecryptReader(backup, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := []string{}
+	err = readArchive(archive, func(header *tar.Header, contents io.Reader) error {
+		targetPath, err := getRestorePath(header.Name, settingsPath, dataPath)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(targetPath, DefaultDirectoryMode); err != nil {
+				return fmt.Errorf("error creating folder [%s]: %w", targetPath, err)
+			}
+
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(targetPath), DefaultDirectoryMode); err != nil {
+				return fmt.Errorf("error creating folder for [%s]: %w", targetPath, err)
+			}
+			file, err := os.OpenFile(targetPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, fs.FileMode(header.Mode).Perm())
+			if err != nil {
+				return fmt.Errorf("error creating file [%s]: %w", targetPath, err)
+			}
+			_, err = io.Copy(file, contents)
+			file.Close()
+			if err != nil {
+				return fmt.Errorf("error writing file [%s]: %w", targetPath, err)
+			}
+			restored = append(restored, targetPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+
+}
+
+// Delete the oldest backups in a folder so only the provided number of backups remain
+func PruneBackups(backupFolder string, retain uint64) ([]string, error) {
+
+	backups, err := GetBackups(backupFolder)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(backups)) <= retain {
+		return nil, nil
+	}
+
+	pruned := []string{}
+	for _, backup := range backups[:uint64(len(backups))-retain] {
+		if err := os.Remove(backup); err != nil {
+			return pruned, fmt.Errorf("error removing old backup [%s]: %w", backup, err)
+		}
+		pruned = append(pruned, backup)
+	}
+	return pruned, nil
+
+}
+
+// Get the paths of the backups in a folder, sorted from oldest to newest
+func GetBackups(backupFolder string) ([]string, error) {
+
+	matches, err := filepath.Glob(filepath.Join(backupFolder, FilenamePrefix+"*"+FileExtension))
+	if err != nil {
+		return nil, fmt.Errorf("error listing backups in [%s]: %w", backupFolder, err)
+	}
+
+	// The timestamp format sorts lexically
+	sort.Strings(matches)
+	return matches, nil
+
+}
+
+// Add a file or folder to the archive; missing paths are skipped
+func addToArchive(tarWriter *tar.Writer, sourcePath string, entryName string) ([]string, error) {
+
+	_, err := os.Stat(sourcePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking [%s]: %w", sourcePath, err)
+	}
+
+	added := []string{}
+	err = filepath.WalkDir(sourcePath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		// Get the name of the entry in the archive
+		relPath, err := filepath.Rel(sourcePath, filePath)
+		if err != nil {
+			return err
+		}
+		name := path.Join(entryName, filepath.ToSlash(relPath))
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if _, err := io.Copy(tarWriter, file); err != nil {
+			return err
+		}
+		added = append(added, name)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding [%s] to backup archive: %w", sourcePath, err)
+	}
+	return added, nil
+
+}
+
+// Iterate over the entries in a compressed archive
+func readArchive(archive io.Reader, handler func(header *tar.Header, contents io.Reader) error) error {
+
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return fmt.Errorf("error decompressing backup archive: %w", err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading backup archive: %w", err)
+		}
+		if err := handler(header, tarReader); err != nil {
+			return err
+		}
+	}
+
+}
+
+// Map an archive entry back to its location on disk
+func getRestorePath(entryName string, settingsPath string, dataPath string) (string, error) {
+
+	cleanName := path.Clean(entryName)
+	if cleanName == SettingsEntryName {
+		return settingsPath, nil
+	}
+
+	dataPrefix := DataFolderEntryName + "/"
+	if !strings.HasPrefix(cleanName, dataPrefix) && cleanName != DataFolderEntryName {
+		return "", fmt.Errorf("backup archive contains an unexpected entry [%s]", entryName)
+	}
+
+	relPath := strings.TrimPrefix(strings.TrimPrefix(cleanName, DataFolderEntryName), "/")
+	targetPath := filepath.Join(dataPath, filepath.FromSlash(relPath))
+	if targetPath != filepath.Clean(dataPath) && !strings.HasPrefix(targetPath, filepath.Clean(dataPath)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("backup archive entry [%s] points outside of the data folder", entryName)
+	}
+	return targetPath, nil
+
+}
+
+// Encrypts a stream in fixed-size chunks with a key derived from the passphrase
+type encryptWriter struct {
+	writer      io.Writer
+	gcm         cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	counter     uint32
+	buffer      []byte
+}
+
+// Start an encrypted stream, writing its header
+func newEncryptWriter(writer io.Writer, passphrase string) (*encryptWriter, error) {
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+	gcm, err := getCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	noncePrefix := make([]byte, gcm.NonceSize()-noncePrefixOverhead)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	// Format is magic || salt || nonce prefix || chunks; the header is authenticated as additional data of every chunk
+	header := append([]byte(magic), salt...)
+	header = append(header, noncePrefix...)
+	if _, err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		writer:      writer,
+		gcm:         gcm,
+		header:      header,
+		noncePrefix: noncePrefix,
+		buffer:      make([]byte, 0, chunkSize),
+	}, nil
+
+}
+
+func (w *encryptWriter) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		// A full chunk is only sealed once more data arrives, so the final chunk is always sealed by Close
+		if len(w.buffer) == chunkSize {
+			if err := w.sealChunk(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(w.buffer[len(w.buffer):chunkSize], data)
+		w.buffer = w.buffer[:len(w.buffer)+n]
+		data = data[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Seal the final chunk, which marks the end of the stream
+func (w *encryptWriter) Close() error {
+	return w.sealChunk(true)
+}
+
+// Encrypt and write the buffered chunk
+func (w *encryptWriter) sealChunk(last bool) error {
+	nonce := getChunkNonce(w.noncePrefix, w.counter, last)
+	if _, err := w.writer.Write(w.gcm.Seal(nil, nonce, w.buffer, w.header)); err != nil {
+		return err
+	}
+	w.counter++
+	w.buffer = w.buffer[:0]
+	return nil
+}
+
+// Decrypts a stream written by an encryptWriter
+type decryptReader struct {
+	reader      *bufio.Reader
+	gcm         cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	counter     uint32
+	sealed      []byte
+	plaintext   []byte
+	done        bool
+}
+
+// Read the header of an encrypted stream and start decrypting it
+func newDecryptReader(reader io.Reader, passphrase string) (*decryptReader, error) {
+
+	bufferedReader := bufio.NewReader(reader)
+	prefix := make([]byte, len(magic)+saltLength)
+	if _, err := io.ReadFull(bufferedReader, prefix); err != nil || string(prefix[:len(magic)]) != magic {
+		return nil, fmt.Errorf("this is not a Smartnode backup file")
+	}
+	salt := prefix[len(magic):]
+
+	gcm, err := getCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	noncePrefix := make([]byte, gcm.NonceSize()-noncePrefixOverhead)
+	if _, err := io.ReadFull(bufferedReader, noncePrefix); err != nil {
+		return nil, fmt.Errorf("the backup file is truncated")
+	}
+	r := &decryptReader{
+		reader:      bufferedReader,
+		gcm:         gcm,
+		header:      append(prefix, noncePrefix...),
+		noncePrefix: noncePrefix,
+		sealed:      make([]byte, chunkSize+gcm.Overhead()),
+	}
+
+	// Open the first chunk now so a wrong passphrase is reported before anything is read
+	if err := r.openChunk(); err != nil {
+		return nil, err
+	}
+	return r, nil
+
+}
+
+func (r *decryptReader) Read(data []byte) (int, error) {
+	for len(r.plaintext) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(data, r.plaintext)
+	r.plaintext = r.plaintext[n:]
+	return n, nil
+}
+
+// Read and decrypt the next chunk
+func (r *decryptReader) openChunk() error {
+	n, err := io.ReadFull(r.reader, r.sealed)
+	last := false
+	switch {
+	case err == io.ErrUnexpectedEOF:
+		last = true
+	case err == io.EOF:
+		return fmt.Errorf("the backup file is truncated")
+	case err != nil:
+		return fmt.Errorf("error reading backup file: %w", err)
+	default:
+		// A full chunk is the last one if nothing follows it
+		if _, err := r.reader.Peek(1); err == io.EOF {
+			last = true
+		}
+	}
+
+	nonce := getChunkNonce(r.noncePrefix, r.counter, last)
+	plaintext, err := r.gcm.Open(nil, nonce, r.sealed[:n], r.header)
+	if err != nil {
+		return fmt.Errorf("could not decrypt the backup; the passphrase may be incorrect or the file may be corrupted")
+	}
+	r.counter++
+	r.plaintext = plaintext
+	r.done = last
+	return nil
+}
+
+// Get the nonce of a chunk from the stream's nonce prefix, the chunk's index, and whether it's the final chunk
+func getChunkNonce(noncePrefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, len(noncePrefix)+noncePrefixOverhead)
+	copy(nonce, noncePrefix)
+	binary.BigEndian.PutUint32(nonce[len(noncePrefix):], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// Get an AES-GCM cipher with a key derived from the passphrase and salt
+func getCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving backup key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup cipher: %w", err)
+	}
+	return gcm, nil
+
+}
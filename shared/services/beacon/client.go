@@ -26,6 +26,7 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64
 	SecondsPerEpoch              uint64
 	EpochsPerSyncCommitteePeriod uint64
+	CapellaForkVersion           []byte
 }
 type Eth2DepositContract struct {
 	ChainID uint64
@@ -63,6 +64,13 @@ type BeaconBlock struct {
 	Attestations         []AttestationInfo
 	FeeRecipient         common.Address
 	ExecutionBlockNumber uint64
+	Withdrawals          []WithdrawalInfo
+}
+
+type WithdrawalInfo struct {
+	ValidatorIndex uint64
+	Address        common.Address
+	Amount         uint64
 }
 
 type Committee struct {
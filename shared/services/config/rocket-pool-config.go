@@ -1085,6 +1085,19 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		errors = append(errors, "You are using an externally-managed Execution client and a locally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.")
 	}
 
+	// Only the password file works across the Docker containers
+	if !cfg.IsNativeMode {
+		passwordStorageMode := cfg.Smartnode.PasswordStorageMode.Value.(config.PasswordStorageMode)
+		if passwordStorageMode != config.PasswordStorageMode_File && passwordStorageMode != config.PasswordStorageMode_Unknown {
+			errors = append(errors, fmt.Sprintf("You have the node wallet password stored with [%s], but that is only available in Native mode. Please select File as your Password Storage in the Smartnode settings.", passwordStorageMode))
+		}
+	}
+
+	// Check the automatic transaction gas overrides
+	if _, err := cfg.Smartnode.GetAutoTxGasOverrides(); err != nil {
+		errors = append(errors, fmt.Sprintf("Your Automatic TX Gas Overrides are invalid: %s.", err.Error()))
+	}
+
 	// Ensure there's a MEV-boost URL
 	if !cfg.IsNativeMode && cfg.EnableMevBoost.Value == true {
 		switch cfg.MevBoost.Mode.Value.(config.Mode) {
@@ -2,8 +2,12 @@ package config
 
 import (
 	"fmt"
+	"math/big"
 	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/Seb369888/poolsea-go/utils/eth"
 	"github.com/Seb369888/smartnode/shared"
 	"github.com/Seb369888/smartnode/shared/types/config"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +26,7 @@ const (
 	RewardsTreeIpfsExtension           string = ".zst"
 	RewardsTreesFolder                 string = "rewards-trees"
 	DaemonDataPath                     string = "/.rocketpool/data"
+	DaemonBackupPath                   string = "/.rocketpool/backups"
 	WatchtowerFolder                   string = "watchtower"
 	WatchtowerStateFile                string = "state.yml"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
@@ -31,6 +36,9 @@ const (
 	GithubRewardsFileUrl               string = "https://github.com/PoolSea-Staking-Pool/rewards-trees/raw/main/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	AuctionLedgerFilename              string = "auction-ledger.jsonl"
+	AnalyticsFilename                  string = "analytics.db"
+	BackupPassphraseFilename           string = "backup-passphrase"
 )
 
 // Defaults
@@ -40,6 +48,16 @@ const (
 	WatchtowerPrioFeeDefault uint64 = 3
 )
 
+// The automatic transaction tasks that can override the gas policy
+var autoTxGasTasks = []string{"auto-restake", "bid", "distribute", "promote", "reduce-bond", "stake"}
+
+// A task's overrides of the automatic transaction gas policy; nil values use the global settings
+type AutoTxGasOverride struct {
+	Threshold *float64
+	Ceiling   *float64
+	RampStart *float64
+}
+
 // Configuration for the Smartnode
 type SmartnodeConfig struct {
 	Title string `yaml:"-"`
@@ -69,12 +87,93 @@ type SmartnodeConfig struct {
 	// Manual priority fee override
 	PriorityFee config.Parameter `yaml:"priorityFee,omitempty"`
 
+	// The number of recent blocks to use for fee suggestions
+	GasFeeHistoryBlocks config.Parameter `yaml:"gasFeeHistoryBlocks,omitempty"`
+
+	// The priority fee percentiles for the rapid, fast and standard fee suggestions
+	GasFeeHistoryPercentiles config.Parameter `yaml:"gasFeeHistoryPercentiles,omitempty"`
+
+	// The number of blocks to forecast the base fee over
+	GasBaseFeeForecastBlocks config.Parameter `yaml:"gasBaseFeeForecastBlocks,omitempty"`
+
+	// Whether to fall back to third-party gas APIs when the fee history isn't available
+	GasWebFallbacks config.Parameter `yaml:"gasWebFallbacks,omitempty"`
+
+	// The endpoint used to submit transactions privately instead of through the public mempool
+	PrivateTxEndpoint config.Parameter `yaml:"privateTxEndpoint,omitempty"`
+
+	// How long to wait for a private transaction before broadcasting it publicly
+	PrivateTxTimeout config.Parameter `yaml:"privateTxTimeout,omitempty"`
+
+	// Whether withdrawal address changes use the private route
+	PrivateTxWithdrawalAddress config.Parameter `yaml:"privateTxWithdrawalAddress,omitempty"`
+
+	// The smallest RPL stake that uses the private route
+	PrivateTxRplStakeThreshold config.Parameter `yaml:"privateTxRplStakeThreshold,omitempty"`
+
+	// Whether oDAO submissions use the private route
+	PrivateTxOdaoSubmissions config.Parameter `yaml:"privateTxOdaoSubmissions,omitempty"`
+
 	// Threshold for automatic transactions
 	AutoTxGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// Hard ceiling for automatic transactions with deadlines
+	AutoTxGasCeiling config.Parameter `yaml:"autoTxGasCeiling,omitempty"`
+
+	// How far into the deadline window the acceptable fee starts rising towards the ceiling
+	AutoTxGasRampStart config.Parameter `yaml:"autoTxGasRampStart,omitempty"`
+
+	// Per-task overrides of the automatic transaction gas threshold, ceiling and ramp start
+	AutoTxGasOverrides config.Parameter `yaml:"autoTxGasOverrides,omitempty"`
+
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
+	// What the node daemon does with unclaimed rewards
+	AutoRestakeMode config.Parameter `yaml:"autoRestakeMode,omitempty"`
+
+	// The RPL collateral, as a percentage of borrowed ETH, that auto-restake tops the node up to
+	AutoRestakeTargetRatio config.Parameter `yaml:"autoRestakeTargetRatio,omitempty"`
+
+	// How many times the gas cost of claiming the unclaimed rewards must be worth before they're claimed
+	AutoRestakeGasMultiplier config.Parameter `yaml:"autoRestakeGasMultiplier,omitempty"`
+
+	// Whether the node daemon evaluates the alert rules
+	AlertsEnabled config.Parameter `yaml:"alertsEnabled,omitempty"`
+
+	// How many epochs a validator's balance can go without increasing before it's considered offline
+	AlertOfflineEpochs config.Parameter `yaml:"alertOfflineEpochs,omitempty"`
+
+	// The SMTP relay that alerts are emailed through
+	AlertSmtpAddress config.Parameter `yaml:"alertSmtpAddress,omitempty"`
+
+	// The sender of alert emails
+	AlertSmtpFrom config.Parameter `yaml:"alertSmtpFrom,omitempty"`
+
+	// The recipients of alert emails
+	AlertSmtpTo config.Parameter `yaml:"alertSmtpTo,omitempty"`
+
+	// Whether alerts are written to a local log file
+	AlertLogToFile config.Parameter `yaml:"alertLogToFile,omitempty"`
+
+	// The Discord or Slack-compatible webhook that daemon notifications are sent to
+	NotifyChatWebhookUrl config.Parameter `yaml:"notifyChatWebhookUrl,omitempty"`
+
+	// The generic JSON webhook that daemon notifications are sent to
+	NotifyJsonWebhookUrl config.Parameter `yaml:"notifyJsonWebhookUrl,omitempty"`
+
+	// The ntfy topic URL that daemon notifications are sent to
+	NotifyNtfyUrl config.Parameter `yaml:"notifyNtfyUrl,omitempty"`
+
+	// The access token for the ntfy topic
+	NotifyNtfyToken config.Parameter `yaml:"notifyNtfyToken,omitempty"`
+
+	// The most notifications sent to each target per minute
+	NotifyRateLimit config.Parameter `yaml:"notifyRateLimit,omitempty"`
+
+	// How many times in a row a daemon task has to fail before a notification is sent
+	NotifyErrorThreshold config.Parameter `yaml:"notifyErrorThreshold,omitempty"`
+
 	// Mode for acquiring Merkle rewards trees
 	RewardsTreeMode config.Parameter `yaml:"rewardsTreeMode,omitempty"`
 
@@ -90,6 +189,45 @@ type SmartnodeConfig struct {
 	// Manual override for the watchtower's priority fee
 	WatchtowerPrioFeeOverride config.Parameter `yaml:"watchtowerPrioFeeOverride,omitempty"`
 
+	// How long before a proposal's voting period ends to warn about it if the node hasn't voted, in hours
+	ProposalExpiryWarningTime config.Parameter `yaml:"proposalExpiryWarningTime,omitempty"`
+
+	// Whether the watchtower should execute succeeded oracle DAO proposals automatically
+	AutoExecuteProposals config.Parameter `yaml:"autoExecuteProposals,omitempty"`
+
+	// Whether automatic execution is restricted to proposals the node voted in support of
+	AutoExecuteSupportedOnly config.Parameter `yaml:"autoExecuteSupportedOnly,omitempty"`
+
+	// The gas threshold for automatically executing proposals
+	AutoExecuteGasThreshold config.Parameter `yaml:"autoExecuteGasThreshold,omitempty"`
+
+	// Where the node wallet password is stored
+	PasswordStorageMode config.Parameter `yaml:"passwordStorageMode,omitempty"`
+
+	// How often the node daemon should create an encrypted backup of the node's state, in hours
+	AutoBackupInterval config.Parameter `yaml:"autoBackupInterval,omitempty"`
+
+	// The folder to store the node daemon's automatic backups in
+	AutoBackupPath config.Parameter `yaml:"autoBackupPath,omitempty"`
+
+	// The number of automatic backups to keep
+	AutoBackupRetention config.Parameter `yaml:"autoBackupRetention,omitempty"`
+
+	// Whether the node daemon should bid on RPL auction lots automatically
+	AutoBidEnabled config.Parameter `yaml:"autoBidEnabled,omitempty"`
+
+	// The minimum discount below the oracle RPL price, in percent, that a lot must be offered at before the node bids on it
+	AutoBidMinDiscount config.Parameter `yaml:"autoBidMinDiscount,omitempty"`
+
+	// The total amount of ETH the node daemon is allowed to spend on automatic bids
+	AutoBidBudget config.Parameter `yaml:"autoBidBudget,omitempty"`
+
+	// How far back to look at deposit pool deposits when estimating queue wait times, in days
+	QueueEtaLookback config.Parameter `yaml:"queueEtaLookback,omitempty"`
+
+	// How long the node daemon should keep analytics snapshots for, in days
+	AnalyticsRetention config.Parameter `yaml:"analyticsRetention,omitempty"`
+
 	// The epoch to switch over to TWAP for RPL price reporting
 	RplTwapEpoch config.Parameter `yaml:"rplTwapEpoch,omitempty"`
 
@@ -271,12 +409,120 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		GasFeeHistoryBlocks: config.Parameter{
+			ID:                   "gasFeeHistoryBlocks",
+			Name:                 "Fee History Blocks",
+			Description:          "The Smartnode suggests max fees using the fee history of your Execution client instead of third-party gas APIs. This is the number of recent blocks whose priority fees are used for those suggestions.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(20)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GasFeeHistoryPercentiles: config.Parameter{
+			ID:                   "gasFeeHistoryPercentiles",
+			Name:                 "Fee History Percentiles",
+			Description:          "A comma-separated list of the three priority fee percentiles (from 0 to 100) used for the Rapid, Fast and Standard fee suggestions, in that order. Higher percentiles outbid more of the recent transactions.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: "90,60,30"},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GasBaseFeeForecastBlocks: config.Parameter{
+			ID:                   "gasBaseFeeForecastBlocks",
+			Name:                 "Base Fee Forecast Blocks",
+			Description:          "The number of blocks to forecast the base fee over. The Rapid suggestion covers the largest increase the base fee could make over this many blocks, and the others follow the recent trend.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(6)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GasWebFallbacks: config.Parameter{
+			ID:                   "gasWebFallbacks",
+			Name:                 "Use Web Gas APIs as Fallbacks",
+			Description:          "Enable this to ask beaconcha.in and Etherscan for fee suggestions when your Execution client's fee history isn't available. This sends requests to those sites whenever that happens.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PrivateTxEndpoint: config.Parameter{
+			ID:                   "privateTxEndpoint",
+			Name:                 "Private Transaction Endpoint",
+			Description:          "The URL of an endpoint that accepts `eth_sendPrivateTransaction` requests (such as a Flashbots-style relay). The transaction categories enabled below will be sent there instead of to the public mempool, and broadcast publicly if they aren't included in time.\n\nLeave this blank to send every transaction through your Execution client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PrivateTxTimeout: config.Parameter{
+			ID:                   "privateTxTimeout",
+			Name:                 "Private Transaction Timeout",
+			Description:          "How long (in seconds) to wait for a private transaction to be included in a block before broadcasting it through your Execution client instead.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(120)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PrivateTxWithdrawalAddress: config.Parameter{
+			ID:                   "privateTxWithdrawalAddress",
+			Name:                 "Private Withdrawal Address Changes",
+			Description:          "Send withdrawal address changes and confirmations through the Private Transaction Endpoint.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PrivateTxRplStakeThreshold: config.Parameter{
+			ID:                   "privateTxRplStakeThreshold",
+			Name:                 "Private RPL Stake Threshold",
+			Description:          "Send RPL stakes of at least this many RPL through the Private Transaction Endpoint.\n\nSet this to 0 to send every RPL stake publicly.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PrivateTxOdaoSubmissions: config.Parameter{
+			ID:                   "privateTxOdaoSubmissions",
+			Name:                 "Private oDAO Submissions",
+			Description:          "Send every transaction the watchtower makes (including the RPL price submissions to Layer 2 networks and automatic proposal executions) and your Oracle DAO proposal votes and executions through the Private Transaction Endpoint. Only applies to Oracle DAO members.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		AutoTxGasThreshold: config.Parameter{
 			ID:   "minipoolStakeGasThreshold",
 			Name: "Automatic TX Gas Threshold",
 			Description: "Occasionally, the Smartnode will attempt to perform some automatic transactions (such as the second `stake` transaction to finish launching a minipool or the `reduce bond` transaction to convert a 16-ETH minipool to an 8-ETH one). During these, your node will use the `Rapid` suggestion from the gas estimator as its max fee.\n\nThis threshold is a limit (in gwei) you can put on that suggestion; your node will not `stake` the new minipool until the suggestion is below this limit.\n\n" +
 				"A value of 0 will disable non-essential automatic transactions (such as minipool balance distribution and bond reduction), but essential transactions (such as minipool staking and solo migration promotion) will not be disabled.\n\n" +
-				"NOTE: the node will ignore this limit and automatically execute transactions at whatever the suggested fee happens to be once too much time has passed since those transactions were first eligible. You may end up paying more than you wanted to if you set this too low!",
+				"NOTE: for transactions with a deadline, the node will accept more than this limit as the deadline approaches (up to the Automatic TX Gas Ceiling), and if no ceiling is set it will execute them at whatever the suggested fee happens to be once the deadline is reached. You may end up paying more than you wanted to if you set this too low!",
 			Type:                 config.ParameterType_Float,
 			Default:              map[config.Network]interface{}{config.Network_All: float64(150)},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
@@ -285,6 +531,46 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		AutoTxGasCeiling: config.Parameter{
+			ID:   "autoTxGasCeiling",
+			Name: "Automatic TX Gas Ceiling",
+			Description: "Some automatic transactions have a deadline (such as the second `stake` transaction, which must happen before the minipool times out, or the `reduce bond` transaction, which must happen before the bond reduction window closes). As a deadline approaches, the max fee your node will accept for these rises from the Automatic TX Gas Threshold towards this ceiling (in gwei), and reaches it at the deadline.\n\n" +
+				"Your node will never send these transactions with a max fee above this ceiling, even if that means missing the deadline.\n\n" +
+				"A value of 0 means there is no ceiling: your node will wait for the threshold until the deadline and then send the transaction at whatever the suggested fee happens to be.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoTxGasRampStart: config.Parameter{
+			ID:                   "autoTxGasRampStart",
+			Name:                 "Automatic TX Gas Ramp Start",
+			Description:          "How far (as a percentage from 0 to 100) into the time before a deadline your node waits before it starts raising the max fee it will accept from the Automatic TX Gas Threshold towards the Automatic TX Gas Ceiling. Only applies when a ceiling is set.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(50)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoTxGasOverrides: config.Parameter{
+			ID:   "autoTxGasOverrides",
+			Name: "Automatic TX Gas Overrides",
+			Description: "Use a different Automatic TX Gas Threshold, Ceiling or Ramp Start for specific automatic transactions. This is a comma-separated list of `task=threshold/ceiling/rampStart` entries, where the task is one of `stake`, `promote`, `reduce-bond`, `distribute`, `auto-restake` or `bid` (automatic auction bids, claims and recoveries).\n\n" +
+				"Any value left out uses the global setting, so `stake=50/300/25, distribute=10` lets the `stake` transaction ramp from 50 to 300 gwei starting a quarter of the way to its deadline, and only distributes minipool balances when the fee is below 10 gwei.\n\n" +
+				"Leave this blank to use the global settings for every task.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		DistributeThreshold: config.Parameter{
 			ID:                   "distributeThreshold",
 			Name:                 "Auto-Distribute Threshold",
@@ -297,6 +583,203 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		AutoRestakeMode: config.Parameter{
+			ID:                   "autoRestakeMode",
+			Name:                 "Auto-Restake Mode",
+			Description:          "Select what your node should do with the rewards from finished rewards intervals that you haven't claimed yet.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.AutoRestakeMode_Disabled},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Disabled",
+				Description: "Don't check for unclaimed rewards. You can claim them yourself with `rocketpool node claim-rewards`.",
+				Value:       config.AutoRestakeMode_Disabled,
+			}, {
+				Name:        "Notify",
+				Description: "Log a message and send a notification when your unclaimed rewards are worth claiming, but don't plan or send anything.",
+				Value:       config.AutoRestakeMode_Notify,
+			}, {
+				Name:        "Dry Run",
+				Description: "Log how much RPL your node would restake and how much would go to your withdrawal address, and estimate the gas of the claim, but don't send it. Use this to check the settings before enabling them.",
+				Value:       config.AutoRestakeMode_DryRun,
+			}, {
+				Name:        "Enabled",
+				Description: "Automatically claim your rewards once they're worth claiming, restaking enough RPL to reach the Auto-Restake Target Ratio. The rest of the RPL and all of the ETH go to your withdrawal address.",
+				Value:       config.AutoRestakeMode_Enabled,
+			}},
+		},
+
+		AutoRestakeTargetRatio: config.Parameter{
+			ID:                   "autoRestakeTargetRatio",
+			Name:                 "Auto-Restake Target Ratio",
+			Description:          "The value of your staked RPL, as a percentage of the ETH your minipools borrowed from the staking pool, that auto-restake tops your node up to. For example, 150 restakes claimed RPL until your stake is worth 150% of your borrowed ETH; anything claimed beyond that goes to your withdrawal address.\n\nSet this to 0 to never restake and send all of your claimed RPL to your withdrawal address.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(150)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoRestakeGasMultiplier: config.Parameter{
+			ID:                   "autoRestakeGasMultiplier",
+			Name:                 "Auto-Restake Gas Multiplier",
+			Description:          "Your unclaimed rewards (valued in ETH) must be worth at least this many times the gas cost of claiming them before your node claims them automatically. Higher values mean fewer, larger claims.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(10)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertsEnabled: config.Parameter{
+			ID:                   "alertsEnabled",
+			Name:                 "Enable Alerts",
+			Description:          "Have the node daemon check your node and minipools for problems (such as offline validators, minipools that haven't been staked, low RPL collateral or the wrong fee recipient) each time it updates, and send an alert through the notification targets and the sinks below when one starts or stops. Each problem is only alerted once until it's resolved.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertOfflineEpochs: config.Parameter{
+			ID:                   "alertOfflineEpochs",
+			Name:                 "Offline Alert Epochs",
+			Description:          "How many epochs an active validator's balance can go without increasing before it's considered offline.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(3)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertSmtpAddress: config.Parameter{
+			ID:                   "alertSmtpAddress",
+			Name:                 "Alert SMTP Relay",
+			Description:          "The address (`host:port`) of an SMTP relay that accepts mail without authentication, such as a local mail server, to email alerts through.\n\nLeave this blank to disable email alerts.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertSmtpFrom: config.Parameter{
+			ID:                   "alertSmtpFrom",
+			Name:                 "Alert Email Sender",
+			Description:          "The address alert emails are sent from.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertSmtpTo: config.Parameter{
+			ID:                   "alertSmtpTo",
+			Name:                 "Alert Email Recipients",
+			Description:          "A comma-separated list of the addresses to email alerts to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AlertLogToFile: config.Parameter{
+			ID:                   "alertLogToFile",
+			Name:                 "Log Alerts to File",
+			Description:          "Write alerts to the `alerts.jsonl` file in the Smartnode's data folder, one JSON alert per line.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyChatWebhookUrl: config.Parameter{
+			ID:                   "notifyChatWebhookUrl",
+			Name:                 "Discord / Slack Webhook URL",
+			Description:          "The URL of a Discord webhook, or any webhook that accepts Slack's message format, to send a notification to when the daemons stake a minipool, distribute a balance, reduce a bond, download or submit a rewards tree, see an oracle DAO proposal change, raise or resolve an alert, find unclaimed rewards worth claiming in the Notify auto-restake mode, or keep failing a task.\n\nThe message for each event can be changed with a `notification-templates.json` file in the Smartnode's data folder that maps event types to Go templates.\n\nLeave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyJsonWebhookUrl: config.Parameter{
+			ID:                   "notifyJsonWebhookUrl",
+			Name:                 "JSON Notification Webhook URL",
+			Description:          "The URL to post daemon notifications to as JSON objects with the event's type, time and details.\n\nLeave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyNtfyUrl: config.Parameter{
+			ID:                   "notifyNtfyUrl",
+			Name:                 "ntfy Topic URL",
+			Description:          "The URL of an ntfy topic (such as `https://ntfy.sh/my-topic`) to publish daemon notifications to.\n\nLeave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyNtfyToken: config.Parameter{
+			ID:                   "notifyNtfyToken",
+			Name:                 "ntfy Access Token",
+			Description:          "The access token for the ntfy topic, if it needs one.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyRateLimit: config.Parameter{
+			ID:                   "notifyRateLimit",
+			Name:                 "Notification Rate Limit",
+			Description:          "The most notifications to send to each target per minute. Extra notifications wait their turn.\n\nSet this to 0 to disable the limit.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(10)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyErrorThreshold: config.Parameter{
+			ID:                   "notifyErrorThreshold",
+			Name:                 "Notification Error Threshold",
+			Description:          "How many times in a row one of the daemons' tasks has to fail before a notification is sent about it.\n\nSet this to 0 to disable error notifications.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(3)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		RewardsTreeMode: config.Parameter{
 			ID:                   "rewardsTreeMode",
 			Name:                 "Rewards Tree Mode",
@@ -321,7 +804,7 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 		ArchiveECUrl: config.Parameter{
 			ID:                   "archiveECUrl",
 			Name:                 "Archive-Mode EC URL",
-			Description:          "[orange]**For manual Merkle rewards tree generation only.**[white]\n\nGenerating the Merkle rewards tree files for past rewards intervals typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files for intervals from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
+			Description:          "[orange]**For manual Merkle rewards tree generation only.**[white]\n\nGenerating the Merkle rewards tree files for past rewards intervals typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files for intervals from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nIt is also used by `node export-ledger` to find the transactions your node sent and the RPL price in ranges your primary client no longer has the state for.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
 			Type:                 config.ParameterType_String,
 			Default:              map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
@@ -366,6 +849,179 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   true,
 		},
 
+		ProposalExpiryWarningTime: config.Parameter{
+			ID:                   "proposalExpiryWarningTime",
+			Name:                 "Proposal Expiry Warning Time",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The watchtower will warn you about any oracle DAO proposal that you haven't voted on once its voting period is this close (in hours) to ending.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(24)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoExecuteProposals: config.Parameter{
+			ID:                   "autoExecuteProposals",
+			Name:                 "Auto-Execute Proposals",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Enable this to have the watchtower automatically execute oracle DAO proposals that have succeeded, as long as they are still within their execution window.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoExecuteSupportedOnly: config.Parameter{
+			ID:                   "autoExecuteSupportedOnly",
+			Name:                 "Only Auto-Execute Supported Proposals",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]When automatic proposal execution is enabled, only execute proposals that your node voted in support of.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoExecuteGasThreshold: config.Parameter{
+			ID:                   "autoExecuteGasThreshold",
+			Name:                 "Auto-Execute Gas Threshold",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The watchtower will not automatically execute a proposal while the suggested max fee is above this limit (in gwei); it will try again on a later cycle.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(50)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		PasswordStorageMode: config.Parameter{
+			ID:                   "passwordStorageMode",
+			Name:                 "Password Storage",
+			Description:          "Select where the Smartnode should keep the password for your node wallet. The daemons need this password to sign transactions.\n\n[orange]NOTE: Changing this will not move an existing password. Run `rocketpool wallet unlock` afterwards to store your password in the new location.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.PasswordStorageMode_File},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "File",
+				Description: "Store the password in a plaintext file named `password` in your data folder, readable only by its owner.",
+				Value:       config.PasswordStorageMode_File,
+			}, {
+				Name:        "OS Keyring",
+				Description: "Store the password in your operating system's keyring using the Secret Service API (such as GNOME Keyring or KeePassXC). Requires the `secret-tool` utility and an unlocked keyring session.\n\n[orange]Only available in Native mode.",
+				Value:       config.PasswordStorageMode_Keyring,
+			}, {
+				Name:        "systemd-creds",
+				Description: "Store the password in a credential file named `password.cred` in your data folder, encrypted with `systemd-creds` and sealed to this machine's TPM2 chip when it has one. If the daemons are run by systemd with `LoadCredentialEncrypted=rocketpool-wallet-password:<path>`, they will use the credential systemd provides.\n\n[orange]Only available in Native mode.",
+				Value:       config.PasswordStorageMode_SystemdCreds,
+			}, {
+				Name:        "Memory Only",
+				Description: "Never store the password on disk. The node daemon holds it in memory and shares it with the other Smartnode processes over a socket in your data folder.\n\nProvide it at startup through the `ROCKETPOOL_WALLET_PASSWORD` environment variable of the node daemon, or with `rocketpool wallet unlock` after the node daemon starts.\n\n[orange]WARNING: Your node will not be able to sign anything after a restart until you provide the password again!\n\nOnly available in Native mode.",
+				Value:       config.PasswordStorageMode_Memory,
+			}},
+		},
+
+		AutoBackupInterval: config.Parameter{
+			ID:                   "autoBackupInterval",
+			Name:                 "Automatic Backup Interval",
+			Description:          "How often (in hours) the Smartnode should create an encrypted backup of your node's state. This includes your settings, your encrypted node wallet, any custom validator keys, your fee recipient files, the watchtower state, and your rewards tree files.\n\nAutomatic backups are encrypted with a dedicated backup passphrase, which you can set with `rocketpool service set-backup-passphrase`. You will need that passphrase to restore them with `rocketpool service restore`.\n\nSet this to 0 to disable automatic backups.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoBackupPath: config.Parameter{
+			ID:                   "autoBackupPath",
+			Name:                 "Automatic Backup Path",
+			Description:          "The absolute path of the folder on this machine that the Smartnode's automatic backups will be saved to. In Docker mode, this folder is mounted into the node container when automatic backups are enabled.\n\nYou should keep a copy of these backups somewhere other than this machine.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: "$HOME/.rocketpool/backups"},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoBackupRetention: config.Parameter{
+			ID:                   "autoBackupRetention",
+			Name:                 "Automatic Backup Retention",
+			Description:          "The number of automatic backups to keep. Once this many backups have been created, the oldest one will be deleted whenever a new one is made.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(7)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoBidEnabled: config.Parameter{
+			ID:                   "autoBidEnabled",
+			Name:                 "Enable Automatic Auction Bidding",
+			Description:          "Enable this to have the node daemon bid on RPL auction lots automatically when they are offered at a sufficient discount, claim the RPL from lots it won, and recover unclaimed RPL from lots it bid on that ended without selling out.\n\nEvery bid, claim and recovery is recorded in a ledger file in your data folder once it has been mined.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoBidMinDiscount: config.Parameter{
+			ID:                   "autoBidMinDiscount",
+			Name:                 "Automatic Bid Minimum Discount",
+			Description:          "The node daemon will only bid on a lot once its current price is at least this far (in percent) below the oracle RPL price.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(5)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoBidBudget: config.Parameter{
+			ID:                   "autoBidBudget",
+			Name:                 "Automatic Bid Budget",
+			Description:          "The total amount of ETH the node daemon may spend on automatic bids. The amount already spent is taken from the successful bids in the auction ledger, so delete or move the ledger file to reset it.\n\nSet this to 0 to disable automatic bidding; claims and recoveries will still be made.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		QueueEtaLookback: config.Parameter{
+			ID:                   "queueEtaLookback",
+			Name:                 "Queue ETA Lookback",
+			Description:          "The number of days of deposit pool deposits to average when estimating how long your queued minipools will wait before they are assigned.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(7)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AnalyticsRetention: config.Parameter{
+			ID:                   "analyticsRetention",
+			Name:                 "Analytics Retention",
+			Description:          "The node daemon records a snapshot of your node's RPL stake, collateral ratio, rETH rate and minipool balances after each network state update, which the `analytics` command uses to show how they changed over time. This is the number of days to keep those snapshots for.\n\nSet this to 0 to stop recording snapshots.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(365)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		RplTwapEpoch: config.Parameter{
 			ID:          "rplTwapEpoch",
 			Name:        "RPL TWAP Epoch",
@@ -701,13 +1357,53 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.DataPath,
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
+		&cfg.GasFeeHistoryBlocks,
+		&cfg.GasFeeHistoryPercentiles,
+		&cfg.GasBaseFeeForecastBlocks,
+		&cfg.GasWebFallbacks,
+		&cfg.PrivateTxEndpoint,
+		&cfg.PrivateTxTimeout,
+		&cfg.PrivateTxWithdrawalAddress,
+		&cfg.PrivateTxRplStakeThreshold,
+		&cfg.PrivateTxOdaoSubmissions,
 		&cfg.AutoTxGasThreshold,
+		&cfg.AutoTxGasCeiling,
+		&cfg.AutoTxGasRampStart,
+		&cfg.AutoTxGasOverrides,
 		&cfg.DistributeThreshold,
+		&cfg.AutoRestakeMode,
+		&cfg.AutoRestakeTargetRatio,
+		&cfg.AutoRestakeGasMultiplier,
+		&cfg.AlertsEnabled,
+		&cfg.AlertOfflineEpochs,
+		&cfg.AlertSmtpAddress,
+		&cfg.AlertSmtpFrom,
+		&cfg.AlertSmtpTo,
+		&cfg.AlertLogToFile,
+		&cfg.NotifyChatWebhookUrl,
+		&cfg.NotifyJsonWebhookUrl,
+		&cfg.NotifyNtfyUrl,
+		&cfg.NotifyNtfyToken,
+		&cfg.NotifyRateLimit,
+		&cfg.NotifyErrorThreshold,
 		&cfg.RewardsTreeMode,
 		&cfg.ArchiveECUrl,
 		&cfg.Web3StorageApiToken,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
+		&cfg.ProposalExpiryWarningTime,
+		&cfg.AutoExecuteProposals,
+		&cfg.AutoExecuteSupportedOnly,
+		&cfg.AutoExecuteGasThreshold,
+		&cfg.PasswordStorageMode,
+		&cfg.AutoBackupInterval,
+		&cfg.AutoBackupPath,
+		&cfg.AutoBackupRetention,
+		&cfg.AutoBidEnabled,
+		&cfg.AutoBidMinDiscount,
+		&cfg.AutoBidBudget,
+		&cfg.QueueEtaLookback,
+		&cfg.AnalyticsRetention,
 		&cfg.RplTwapEpoch,
 		&cfg.BalancesModernizationEpoch,
 		&cfg.NewFeeDistributorCalcEpoch,
@@ -744,6 +1440,62 @@ func (cfg *SmartnodeConfig) GetPasswordPath() string {
 	return filepath.Join(DaemonDataPath, "password")
 }
 
+func (cfg *SmartnodeConfig) GetPasswordCredentialPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "password.cred")
+	}
+
+	return filepath.Join(DaemonDataPath, "password.cred")
+}
+
+func (cfg *SmartnodeConfig) GetPasswordAgentSocketPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "password.sock")
+	}
+
+	return filepath.Join(DaemonDataPath, "password.sock")
+}
+
+func (cfg *SmartnodeConfig) GetNonceManagerPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "nonces.json")
+	}
+
+	return filepath.Join(DaemonDataPath, "nonces.json")
+}
+
+func (cfg *SmartnodeConfig) GetMinipoolEventLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "minipool-events.jsonl")
+	}
+
+	return filepath.Join(DaemonDataPath, "minipool-events.jsonl")
+}
+
+func (cfg *SmartnodeConfig) GetAlertStatePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "alerts-state.json")
+	}
+
+	return filepath.Join(DaemonDataPath, "alerts-state.json")
+}
+
+func (cfg *SmartnodeConfig) GetAlertLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "alerts.jsonl")
+	}
+
+	return filepath.Join(DaemonDataPath, "alerts.jsonl")
+}
+
+func (cfg *SmartnodeConfig) GetNotificationTemplatesPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "notification-templates.json")
+	}
+
+	return filepath.Join(DaemonDataPath, "notification-templates.json")
+}
+
 func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "validators")
@@ -772,6 +1524,14 @@ func (config *SmartnodeConfig) GetWatchtowerStatePath() string {
 	return filepath.Join(DaemonDataPath, WatchtowerFolder, "state.yml")
 }
 
+func (cfg *SmartnodeConfig) GetProposalWatchStatePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder, "proposals-state.json")
+	}
+
+	return filepath.Join(DaemonDataPath, WatchtowerFolder, "proposals-state.json")
+}
+
 func (cfg *SmartnodeConfig) GetCustomKeyPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "custom-keys")
@@ -881,6 +1641,110 @@ func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder)
 }
 
+func (cfg *SmartnodeConfig) GetDataFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return DaemonDataPath
+	}
+
+	return cfg.DataPath.Value.(string)
+}
+
+func (cfg *SmartnodeConfig) GetAutoBackupFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return DaemonBackupPath
+	}
+
+	return cfg.AutoBackupPath.Value.(string)
+}
+
+func (cfg *SmartnodeConfig) GetBackupPassphrasePath(daemon bool) string {
+	return filepath.Join(cfg.GetDataFolder(daemon), BackupPassphraseFilename)
+}
+
+func (cfg *SmartnodeConfig) GetAuctionLedgerPath(daemon bool) string {
+	return filepath.Join(cfg.GetDataFolder(daemon), AuctionLedgerFilename)
+}
+
+func (cfg *SmartnodeConfig) GetAnalyticsPath(daemon bool) string {
+	return filepath.Join(cfg.GetDataFolder(daemon), AnalyticsFilename)
+}
+
+// Check if an RPL stake is large enough to use the private transaction route
+func (cfg *SmartnodeConfig) IsPrivateRplStake(amountWei *big.Int) bool {
+	threshold := cfg.PrivateTxRplStakeThreshold.Value.(float64)
+	return threshold > 0 && eth.WeiToEth(amountWei) >= threshold
+}
+
+// Get the priority fee percentiles for the rapid, fast and standard fee suggestions
+func (cfg *SmartnodeConfig) GetGasFeeHistoryPercentiles() ([]float64, error) {
+	value := cfg.GasFeeHistoryPercentiles.Value.(string)
+	elements := strings.Split(value, ",")
+	if len(elements) != 3 {
+		return nil, fmt.Errorf("expected 3 fee history percentiles but got '%s'", value)
+	}
+	percentiles := make([]float64, len(elements))
+	for i, element := range elements {
+		percentile, err := strconv.ParseFloat(strings.TrimSpace(element), 64)
+		if err != nil || percentile < 0 || percentile > 100 {
+			return nil, fmt.Errorf("invalid fee history percentile '%s': must be a number from 0 to 100", element)
+		}
+		if i > 0 && percentile > percentiles[i-1] {
+			return nil, fmt.Errorf("fee history percentiles '%s' must be in descending order (rapid, fast, standard)", value)
+		}
+		percentiles[i] = percentile
+	}
+	return percentiles, nil
+}
+
+// Get the automatic transaction gas policy overrides of each task
+func (cfg *SmartnodeConfig) GetAutoTxGasOverrides() (map[string]AutoTxGasOverride, error) {
+	overrides := map[string]AutoTxGasOverride{}
+	value := strings.TrimSpace(cfg.AutoTxGasOverrides.Value.(string))
+	if value == "" {
+		return overrides, nil
+	}
+	for _, entry := range strings.Split(value, ",") {
+		task, settings, found := strings.Cut(strings.TrimSpace(entry), "=")
+		task = strings.TrimSpace(task)
+		if !found {
+			return nil, fmt.Errorf("invalid automatic tx gas override '%s': expected task=threshold/ceiling/rampStart", entry)
+		}
+		known := false
+		for _, autoTxTask := range autoTxGasTasks {
+			known = known || task == autoTxTask
+		}
+		if !known {
+			return nil, fmt.Errorf("invalid automatic tx gas override '%s': unknown task '%s' (expected one of %s)", entry, task, strings.Join(autoTxGasTasks, ", "))
+		}
+		if _, exists := overrides[task]; exists {
+			return nil, fmt.Errorf("the automatic tx gas for task '%s' is overridden more than once", task)
+		}
+
+		elements := strings.Split(settings, "/")
+		if len(elements) > 3 {
+			return nil, fmt.Errorf("invalid automatic tx gas override '%s': expected at most a threshold, ceiling and ramp start", entry)
+		}
+		values := make([]*float64, 3)
+		for i, element := range elements {
+			element = strings.TrimSpace(element)
+			if element == "" {
+				continue
+			}
+			number, err := strconv.ParseFloat(element, 64)
+			if err != nil || number < 0 || (i == 2 && number > 100) {
+				return nil, fmt.Errorf("invalid automatic tx gas override '%s': '%s' must be a non-negative number (and the ramp start must be at most 100)", entry, element)
+			}
+			values[i] = &number
+		}
+		overrides[task] = AutoTxGasOverride{
+			Threshold: values[0],
+			Ceiling:   values[1],
+			RampStart: values[2],
+		}
+	}
+	return overrides, nil
+}
+
 func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
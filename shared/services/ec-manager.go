@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -9,13 +11,18 @@ import (
 	"time"
 
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/nonce"
+	"github.com/Seb369888/smartnode/shared/services/privatetx"
+	"github.com/Seb369888/smartnode/shared/services/simulation"
 	"github.com/Seb369888/smartnode/shared/types/api"
 	cfgtypes "github.com/Seb369888/smartnode/shared/types/config"
 	"github.com/Seb369888/smartnode/shared/utils/log"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/fatih/color"
 )
 
@@ -29,8 +36,17 @@ type ExecutionClientManager struct {
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+	simulateOnly    bool
+	privateTx       *privatetx.Router
+	getSlotTime     func() (time.Duration, error)
+	getContractAbis func(address common.Address) []*abi.ABI
+	nonceManager    *nonce.Manager
+	rpcClients      map[*ethclient.Client]*rpc.Client
 }
 
+// How often to check if a private transaction has been included
+const privateTxPollInterval time.Duration = 6 * time.Second
+
 // This is a signature for a wrapped ethclient.Client function
 type ecFunction func(*ethclient.Client) (interface{}, error)
 
@@ -64,27 +80,37 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		}
 	}
 
-	primaryEc, err := ethclient.Dial(primaryEcUrl)
+	primaryRpc, err := rpc.Dial(primaryEcUrl)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
 	}
+	primaryEc := ethclient.NewClient(primaryRpc)
+	rpcClients := map[*ethclient.Client]*rpc.Client{
+		primaryEc: primaryRpc,
+	}
 
 	var fallbackEc *ethclient.Client
 	if fallbackEcUrl != "" {
-		fallbackEc, err = ethclient.Dial(fallbackEcUrl)
+		fallbackRpc, err := rpc.Dial(fallbackEcUrl)
 		if err != nil {
 			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
 		}
+		fallbackEc = ethclient.NewClient(fallbackRpc)
+		rpcClients[fallbackEc] = fallbackRpc
 	}
 
+	logger := log.NewColorLogger(color.FgYellow)
 	return &ExecutionClientManager{
 		primaryEcUrl:  primaryEcUrl,
 		fallbackEcUrl: fallbackEcUrl,
 		primaryEc:     primaryEc,
 		fallbackEc:    fallbackEc,
-		logger:        log.NewColorLogger(color.FgYellow),
+		logger:        logger,
 		primaryReady:  true,
 		fallbackReady: fallbackEc != nil,
+		privateTx:     privatetx.NewRouter(cfg),
+		nonceManager:  nonce.NewManager(cfg.Smartnode.GetNonceManagerPath(), logger),
+		rpcClients:    rpcClients,
 	}, nil
 
 }
@@ -117,6 +143,31 @@ func (p *ExecutionClientManager) CallContract(ctx context.Context, call ethereum
 	return result.([]byte), err
 }
 
+// PendingCallContract executes an Ethereum contract call against the pending state.
+func (p *ExecutionClientManager) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.PendingCallContract(ctx, call)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), err
+}
+
+// TraceCall runs a call against the state of the given block tag with the given tracer and returns the trace.
+// This requires the client to expose the debug namespace.
+func (p *ExecutionClientManager) TraceCall(ctx context.Context, call ethereum.CallMsg, blockTag string, tracer string) (json.RawMessage, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		var trace json.RawMessage
+		err := p.rpcClients[client].CallContext(ctx, &trace, "debug_traceCall", simulation.ToCallArg(call), blockTag, map[string]interface{}{"tracer": tracer})
+		return trace, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(json.RawMessage), err
+}
+
 /// ============================
 /// ContractTransactor Functions
 /// ============================
@@ -132,6 +183,18 @@ func (p *ExecutionClientManager) HeaderByHash(ctx context.Context, hash common.H
 	return result.(*types.Header), err
 }
 
+// BlockByNumber returns a block from the current canonical chain. If number is nil, the
+// latest known block is returned.
+func (p *ExecutionClientManager) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*types.Block), err
+}
+
 // HeaderByNumber returns a block header from the current canonical chain. If number is
 // nil, the latest known header is returned.
 func (p *ExecutionClientManager) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
@@ -156,7 +219,22 @@ func (p *ExecutionClientManager) PendingCodeAt(ctx context.Context, account comm
 }
 
 // PendingNonceAt retrieves the current pending nonce associated with an account.
+// If the context is marked for a reservation, the nonce is reserved with the nonce manager so other processes won't use it.
 func (p *ExecutionClientManager) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if nonce.IsReserving(ctx) {
+		reservedNonce, err := p.nonceManager.Reserve(account, func() (uint64, error) {
+			return p.getPendingNonce(ctx, account)
+		})
+		if err == nil {
+			return reservedNonce, nil
+		}
+		p.logger.Printlnf("WARNING: couldn't reserve a nonce with the nonce manager (%s), using the pending nonce instead...", err.Error())
+	}
+	return p.getPendingNonce(ctx, account)
+}
+
+// Get the pending nonce of an account from the Execution client
+func (p *ExecutionClientManager) getPendingNonce(ctx context.Context, account common.Address) (uint64, error) {
 	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return client.PendingNonceAt(ctx, account)
 	})
@@ -190,6 +268,17 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the base fees, gas usage ratios and priority fee percentiles of a range of recent blocks.
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ethereum.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
@@ -200,19 +289,147 @@ func (p *ExecutionClientManager) EstimateGas(ctx context.Context, call ethereum.
 		return client.EstimateGas(ctx, call)
 	})
 	if err != nil {
+		// Add the decoded revert reason if the client didn't include it
+		if reason, reverted := simulation.GetRevertReason(err, p.getAbis(call.To)...); reverted && reason != "" && !strings.Contains(err.Error(), reason) {
+			return 0, fmt.Errorf("%w (revert reason: %s)", err, reason)
+		}
 		return 0, err
 	}
+
+	// Simulate it so the balance changes can be shown before it's confirmed
+	if simulation.IsRecordingEstimates() {
+		simulated, err := simulation.SimulateCall(ctx, p, call, p.getAbis(call.To)...)
+		if err != nil {
+			p.logger.Printlnf("WARNING: couldn't simulate the transaction (%s)", err.Error())
+		} else {
+			simulation.RecordEstimate(simulated)
+		}
+	}
 	return result.(uint64), err
 }
 
 // SendTransaction injects the transaction into the pending pool for execution.
+// Every transaction is simulated first, and isn't sent if it would revert or if simulate-only mode is enabled.
+// Transactions in a category that uses the private route are sent there first, and broadcast publicly if that fails or times out.
+// The nonce manager is told whether the transaction's nonce was used.
 func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := p.sendTransaction(ctx, tx)
+	p.recordNonce(tx, err == nil)
+	return err
+}
+
+// Simulate and send a transaction
+func (p *ExecutionClientManager) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := p.simulateTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	if category, ok := privatetx.GetCategory(ctx); ok && p.privateTx.IsEnabled(category) {
+		err := p.sendPrivateTransaction(ctx, category, tx)
+		if err == nil {
+			return nil
+		}
+		p.logger.Printlnf("WARNING: private submission of transaction %s failed (%s), broadcasting it publicly instead...", tx.Hash().Hex(), err.Error())
+	}
+
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
+	if err != nil {
+		// The private submission may have been included in the meantime
+		if _, receiptErr := p.TransactionReceipt(ctx, tx.Hash()); receiptErr == nil {
+			return nil
+		}
+	}
 	return err
 }
 
+// Record the nonce of a transaction as used if it was sent, or release it if it wasn't
+func (p *ExecutionClientManager) recordNonce(tx *types.Transaction, sent bool) {
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		p.logger.Printlnf("WARNING: couldn't get the sender of transaction %s for the nonce manager: %s", tx.Hash().Hex(), err.Error())
+		return
+	}
+	if sent {
+		err = p.nonceManager.MarkSent(sender, tx.Nonce())
+	} else {
+		err = p.nonceManager.Release(sender, tx.Nonce())
+	}
+	if err != nil {
+		p.logger.Printlnf("WARNING: couldn't update the nonce manager for transaction %s: %s", tx.Hash().Hex(), err.Error())
+	}
+}
+
+// Simulate a transaction before it's sent
+func (p *ExecutionClientManager) simulateTransaction(ctx context.Context, tx *types.Transaction) error {
+	result, err := simulation.Simulate(ctx, p, tx, p.getAbis(tx.To())...)
+	if err != nil {
+		if p.simulateOnly {
+			return err
+		}
+		p.logger.Printlnf("WARNING: couldn't simulate transaction %s (%s), sending it anyway...", tx.Hash().Hex(), err.Error())
+		return nil
+	}
+	if p.simulateOnly {
+		return fmt.Errorf("%w\n%s", simulation.ErrSimulateOnly, result.String())
+	}
+	if !result.Success {
+		return errors.New(result.String())
+	}
+	return nil
+}
+
+// Get the ABIs used to decode the custom errors of a contract
+func (p *ExecutionClientManager) getAbis(address *common.Address) []*abi.ABI {
+	if address == nil || p.getContractAbis == nil {
+		return nil
+	}
+	return p.getContractAbis(*address)
+}
+
+// Send a transaction through the private route and wait for it to be included
+func (p *ExecutionClientManager) sendPrivateTransaction(ctx context.Context, category privatetx.Category, tx *types.Transaction) error {
+
+	// Let the endpoint drop the transaction once the timeout has passed
+	if p.getSlotTime == nil {
+		return fmt.Errorf("the slot time isn't available")
+	}
+	slotTime, err := p.getSlotTime()
+	if err != nil {
+		return fmt.Errorf("error getting the slot time: %w", err)
+	}
+	if slotTime == 0 {
+		return fmt.Errorf("the number of seconds per slot cannot be 0")
+	}
+	latestBlock, err := p.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting latest block: %w", err)
+	}
+	timeout := p.privateTx.GetTimeout()
+	maxBlockNumber := latestBlock + uint64(timeout/slotTime) + 1
+
+	p.logger.Printlnf("Sending %s transaction %s through the private transaction endpoint...", category, tx.Hash().Hex())
+	if err := p.privateTx.Send(ctx, tx, maxBlockNumber); err != nil {
+		return err
+	}
+
+	// Wait for it to be included
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if _, err := p.TransactionReceipt(ctx, tx.Hash()); err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(privateTxPollInterval):
+		}
+	}
+	return fmt.Errorf("it was not included within %s", timeout)
+
+}
+
 /// ==========================
 /// ContractFilterer Functions
 /// ==========================
@@ -0,0 +1,155 @@
+package feehistory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// The largest fraction the base fee can change by from one block to the next (EIP-1559)
+const baseFeeMaxChange float64 = 1.0 / 8
+
+// A client that can provide the fee history of recent blocks
+type FeeHistoryClient interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// A single fee suggestion
+type FeeSuggestion struct {
+	// The priority fee percentile of the recent blocks this suggestion is based on
+	Percentile float64 `json:"percentile"`
+
+	// The base fee the suggestion allows for
+	BaseFeeWei *big.Int `json:"baseFeeWei"`
+
+	// The median priority fee paid at the percentile over the recent blocks
+	PriorityFeeWei *big.Int `json:"priorityFeeWei"`
+
+	// The suggested max fee, which is the sum of the two
+	MaxFeeWei *big.Int `json:"maxFeeWei"`
+}
+
+// Fee suggestions and the base fee forecast they're based on
+type GasFeeSuggestion struct {
+	Rapid    FeeSuggestion `json:"rapid"`
+	Fast     FeeSuggestion `json:"fast"`
+	Standard FeeSuggestion `json:"standard"`
+
+	// The base fee of the next block
+	NextBaseFeeWei *big.Int `json:"nextBaseFeeWei"`
+
+	// The base fee after the forecast period if the recent trend in block usage continues
+	ForecastBaseFeeWei *big.Int `json:"forecastBaseFeeWei"`
+
+	// The highest the base fee could be after the forecast period, if every block until then is full
+	MaxBaseFeeWei *big.Int `json:"maxBaseFeeWei"`
+
+	// The number of blocks the base fee was forecast over
+	ForecastBlocks uint64 `json:"forecastBlocks"`
+
+	// The number of recent blocks the suggestions are based on
+	HistoryBlocks uint64 `json:"historyBlocks"`
+}
+
+// Get fee suggestions from the fee history of the latest blocks.
+// The percentiles are for the rapid, fast and standard suggestions, in descending order.
+func GetGasPrices(client FeeHistoryClient, historyBlocks uint64, percentiles []float64, forecastBlocks uint64) (GasFeeSuggestion, error) {
+
+	// Check the settings
+	if historyBlocks == 0 {
+		return GasFeeSuggestion{}, fmt.Errorf("the number of fee history blocks must be greater than 0")
+	}
+	if len(percentiles) != 3 {
+		return GasFeeSuggestion{}, fmt.Errorf("expected 3 fee history percentiles but got %d", len(percentiles))
+	}
+	if forecastBlocks == 0 {
+		forecastBlocks = 1
+	}
+
+	// The fee history needs the percentiles in ascending order
+	ascending := []float64{percentiles[2], percentiles[1], percentiles[0]}
+	history, err := client.FeeHistory(context.Background(), historyBlocks, nil, ascending)
+	if err != nil {
+		return GasFeeSuggestion{}, fmt.Errorf("Could not get the fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return GasFeeSuggestion{}, fmt.Errorf("the fee history did not include any base fees; the Execution client may not support EIP-1559")
+	}
+
+	// The last base fee is the one for the next block
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	// Forecast the base fee from the average block usage, where half-full blocks keep it steady
+	averageUsage := 0.5
+	if len(history.GasUsedRatio) > 0 {
+		total := 0.0
+		for _, ratio := range history.GasUsedRatio {
+			total += ratio
+		}
+		averageUsage = total / float64(len(history.GasUsedRatio))
+	}
+	change := (averageUsage*2 - 1) * baseFeeMaxChange
+	change = math.Max(-baseFeeMaxChange, math.Min(baseFeeMaxChange, change))
+	forecastBaseFee := scaleBaseFee(nextBaseFee, 1+change, forecastBlocks-1)
+	maxBaseFee := scaleBaseFee(nextBaseFee, 1+baseFeeMaxChange, forecastBlocks-1)
+
+	// The rapid suggestion covers the worst case, the fast one covers whichever of the current and forecast base fees is higher
+	fastBaseFee := forecastBaseFee
+	if nextBaseFee.Cmp(fastBaseFee) > 0 {
+		fastBaseFee = nextBaseFee
+	}
+	suggestion := GasFeeSuggestion{
+		Rapid:              newFeeSuggestion(percentiles[0], maxBaseFee, getMedianReward(history, 2)),
+		Fast:               newFeeSuggestion(percentiles[1], fastBaseFee, getMedianReward(history, 1)),
+		Standard:           newFeeSuggestion(percentiles[2], forecastBaseFee, getMedianReward(history, 0)),
+		NextBaseFeeWei:     nextBaseFee,
+		ForecastBaseFeeWei: forecastBaseFee,
+		MaxBaseFeeWei:      maxBaseFee,
+		ForecastBlocks:     forecastBlocks,
+		HistoryBlocks:      uint64(len(history.GasUsedRatio)),
+	}
+	return suggestion, nil
+
+}
+
+// Create a suggestion from its base fee and priority fee
+func newFeeSuggestion(percentile float64, baseFee *big.Int, priorityFee *big.Int) FeeSuggestion {
+	return FeeSuggestion{
+		Percentile:     percentile,
+		BaseFeeWei:     baseFee,
+		PriorityFeeWei: priorityFee,
+		MaxFeeWei:      big.NewInt(0).Add(baseFee, priorityFee),
+	}
+}
+
+// Apply a per-block change factor to a base fee over a number of blocks
+func scaleBaseFee(baseFee *big.Int, factor float64, blocks uint64) *big.Int {
+	scaled := new(big.Float).SetInt(baseFee)
+	scaled.Mul(scaled, big.NewFloat(math.Pow(factor, float64(blocks))))
+	result, _ := scaled.Int(nil)
+	return result
+}
+
+// Get the median priority fee at a percentile index over the blocks that had transactions in them
+func getMedianReward(history *ethereum.FeeHistory, index int) *big.Int {
+	rewards := []*big.Int{}
+	for i, blockRewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if index < len(blockRewards) && blockRewards[index] != nil {
+			rewards = append(rewards, blockRewards[index])
+		}
+	}
+	if len(rewards) == 0 {
+		return big.NewInt(0)
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Cmp(rewards[j]) < 0
+	})
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/Seb369888/poolsea-go/rocketpool"
 	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/Seb369888/smartnode/shared/services/config"
 	"github.com/Seb369888/smartnode/shared/services/gas/etherchain"
 	"github.com/Seb369888/smartnode/shared/services/gas/etherscan"
+	"github.com/Seb369888/smartnode/shared/services/gas/feehistory"
 	rpsvc "github.com/Seb369888/smartnode/shared/services/rocketpool"
 	cliutils "github.com/Seb369888/smartnode/shared/utils/cli"
 	"github.com/Seb369888/smartnode/shared/utils/math"
@@ -28,6 +30,11 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		return fmt.Errorf("Settings file not found. Please run `poolseapool service config` to set up your Smartnode.")
 	}
 
+	// Show the expected outcome of the transactions before they're confirmed
+	for _, result := range rp.TakeSimulations() {
+		fmt.Printf("%s%s%s\n\n", colorBlue, result.String(), colorReset)
+	}
+
 	// Get the current settings from the CLI arguments
 	maxFeeGwei, maxPriorityFeeGwei, gasLimit := rp.GetGasSettings()
 
@@ -66,13 +73,26 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, colorReset)
 
 	} else {
-		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei()
+		// Get the suggestions from the Execution client's fee history
+		suggestionResponse, err := rp.GetGasSuggestions()
+		if err == nil {
+			if headless {
+				maxFeeGwei = eth.WeiToGwei(suggestionResponse.Suggestion.Rapid.MaxFeeWei)
+			} else {
+				maxFeeGwei = handleFeeHistoryGasPrices(suggestionResponse.Suggestion, gasInfo, maxPriorityFeeGwei, gasLimit)
+			}
+		} else if !cfg.Smartnode.GasWebFallbacks.Value.(bool) {
+			return fmt.Errorf("Error getting gas price suggestions: %w", err)
+		} else if headless {
+			fmt.Printf("%sWarning: couldn't get gas estimates from the fee history - %s\nFalling back to web gas APIs%s\n", colorYellow, err.Error(), colorReset)
+			maxFeeWei, err := getWebMaxFeeWei()
 			if err != nil {
 				return err
 			}
 			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
 		} else {
+			fmt.Printf("%sWarning: couldn't get gas estimates from the fee history - %s\nFalling back to Etherchain%s\n", colorYellow, err.Error(), colorReset)
+
 			// Try to get the latest gas prices from Etherchain
 			etherchainData, err := etherchain.GetGasPrices()
 			if err == nil {
@@ -123,8 +143,37 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 
 }
 
+// Get fee suggestions from the fee history of the Execution client
+func GetFeeHistoryGasPrices(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient) (feehistory.GasFeeSuggestion, error) {
+	client, ok := ec.(feehistory.FeeHistoryClient)
+	if !ok {
+		return feehistory.GasFeeSuggestion{}, fmt.Errorf("the Execution client does not support fee history requests")
+	}
+	percentiles, err := cfg.Smartnode.GetGasFeeHistoryPercentiles()
+	if err != nil {
+		return feehistory.GasFeeSuggestion{}, err
+	}
+	historyBlocks := cfg.Smartnode.GasFeeHistoryBlocks.Value.(uint64)
+	forecastBlocks := cfg.Smartnode.GasBaseFeeForecastBlocks.Value.(uint64)
+	return feehistory.GetGasPrices(client, historyBlocks, percentiles, forecastBlocks)
+}
+
 // Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei() (*big.Int, error) {
+func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient) (*big.Int, error) {
+	suggestion, err := GetFeeHistoryGasPrices(cfg, ec)
+	if err == nil {
+		return suggestion.Rapid.MaxFeeWei, nil
+	}
+	if !cfg.Smartnode.GasWebFallbacks.Value.(bool) {
+		return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
+	}
+
+	fmt.Printf("%sWarning: couldn't get gas estimates from the fee history - %s\nFalling back to web gas APIs%s\n", colorYellow, err.Error(), colorReset)
+	return getWebMaxFeeWei()
+}
+
+// Get the suggested max fee for service operations from Etherchain, falling back to Etherscan
+func getWebMaxFeeWei() (*big.Int, error) {
 	etherchainData, err := etherchain.GetGasPrices()
 	if err == nil {
 		return etherchainData.RapidWei, nil
@@ -139,6 +188,71 @@ func GetHeadlessMaxFeeWei() (*big.Int, error) {
 	return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
 }
 
+func handleFeeHistoryGasPrices(gasSuggestion feehistory.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
+
+	tiers := []struct {
+		name       string
+		suggestion feehistory.FeeSuggestion
+	}{
+		{"Rapid", gasSuggestion.Rapid},
+		{"Fast", gasSuggestion.Fast},
+		{"Standard", gasSuggestion.Standard},
+	}
+
+	fmt.Printf("%s+=================== Suggested Gas Prices ===================+\n", colorBlue)
+	fmt.Println("|   Speed   |  Max Fee  | Recent Tip |    Total Gas Cost    |")
+	var fastGwei float64
+	for _, tier := range tiers {
+		maxFeeGwei := math.RoundUp(eth.WeiToGwei(tier.suggestion.BaseFeeWei)+priorityFee, 0)
+		maxFeeEth := maxFeeGwei / eth.WeiPerGwei
+		if tier.name == "Fast" {
+			fastGwei = maxFeeGwei
+		}
+
+		var lowLimit float64
+		var highLimit float64
+		if gasLimit == 0 {
+			lowLimit = maxFeeEth * float64(gasInfo.EstGasLimit)
+			highLimit = maxFeeEth * float64(gasInfo.SafeGasLimit)
+		} else {
+			lowLimit = maxFeeEth * float64(gasLimit)
+			highLimit = lowLimit
+		}
+
+		fmt.Printf("| %-9s | %-9s | %-10s | %.4f to %.4f ETH |\n",
+			tier.name, fmt.Sprintf("%d gwei", int(maxFeeGwei)), fmt.Sprintf("%.2f gwei", eth.WeiToGwei(tier.suggestion.PriorityFeeWei)), lowLimit, highLimit)
+	}
+	fmt.Printf("+============================================================+\n\n%s", colorReset)
+
+	fmt.Printf("Based on the last %d blocks, the base fee is %.2f gwei for the next block and is forecast to be %.2f gwei (at most %.2f gwei) in %d blocks.\n",
+		gasSuggestion.HistoryBlocks, eth.WeiToGwei(gasSuggestion.NextBaseFeeWei), eth.WeiToGwei(gasSuggestion.ForecastBaseFeeWei), eth.WeiToGwei(gasSuggestion.MaxBaseFeeWei), gasSuggestion.ForecastBlocks)
+	fmt.Printf("These prices include a maximum priority fee of %.2f gwei; the recent tips are what other transactions paid at each speed's percentile.\n", priorityFee)
+
+	for {
+		desiredPrice := cliutils.Prompt(
+			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
+			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
+			"Not a valid gas price, try again:")
+
+		if desiredPrice == "" {
+			return fastGwei
+		}
+
+		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
+		if err != nil {
+			fmt.Printf("Not a valid gas price (%s), try again.\n", err.Error())
+			continue
+		}
+		if desiredPriceFloat <= 0 {
+			fmt.Println("Max fee must be greater than zero.")
+			continue
+		}
+
+		return desiredPriceFloat
+	}
+
+}
+
 func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
 
 	rapidGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.RapidWei)+priorityFee, 0)
@@ -0,0 +1,188 @@
+package gas
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/utils/eth"
+
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// The max fee an automatic transaction is willing to pay, and how that changes as its deadline approaches
+type GasPolicy struct {
+	// The name of the task the policy is for
+	Task string
+
+	// The max fee (in gwei) the task will always accept
+	IdealGwei float64
+
+	// The max fee (in gwei) the task will never go above; 0 means there is no ceiling
+	CeilingGwei float64
+
+	// The fraction of the time before the deadline after which the acceptable max fee starts rising towards the ceiling
+	RampStart float64
+}
+
+// The result of checking a max fee against a gas policy
+type GasPolicyDecision struct {
+	Task           string
+	Time           time.Time
+	SuggestedGwei  float64
+	AcceptableGwei float64
+	IdealGwei      float64
+	CeilingGwei    float64
+	Deadline       time.Time
+	Send           bool
+}
+
+// Create the gas policy for an automatic task from the config, using the task's overrides where it has them
+func NewGasPolicy(task string, cfg *config.RocketPoolConfig) (GasPolicy, error) {
+	ideal := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	ceiling := cfg.Smartnode.AutoTxGasCeiling.Value.(float64)
+	rampStart := cfg.Smartnode.AutoTxGasRampStart.Value.(float64)
+
+	overrides, err := cfg.Smartnode.GetAutoTxGasOverrides()
+	if err != nil {
+		return GasPolicy{}, err
+	}
+	if override, exists := overrides[task]; exists {
+		if override.Threshold != nil {
+			ideal = *override.Threshold
+		}
+		if override.Ceiling != nil {
+			ceiling = *override.Ceiling
+		}
+		if override.RampStart != nil {
+			rampStart = *override.RampStart
+		}
+	}
+
+	return GasPolicy{
+		Task:        task,
+		IdealGwei:   ideal,
+		CeilingGwei: ceiling,
+		RampStart:   math.Max(0, math.Min(1, rampStart/100)),
+	}, nil
+}
+
+// Get the max fee (in gwei) the policy accepts at a point in time, for a transaction that became eligible at the start time.
+// A zero deadline means the transaction is optional, so only the ideal max fee is accepted.
+func (p GasPolicy) GetAcceptableMaxFee(start time.Time, deadline time.Time, now time.Time) float64 {
+
+	// Optional transactions never ramp
+	acceptable := p.IdealGwei
+	if !deadline.IsZero() {
+		if p.CeilingGwei == 0 {
+			// Without a ceiling, anything goes once the deadline has been reached
+			if !now.Before(deadline) {
+				acceptable = math.Inf(1)
+			}
+		} else if p.CeilingGwei > p.IdealGwei {
+			// Ramp up linearly from the ideal to the ceiling once the ramp has started
+			progress := 1.0
+			if window := deadline.Sub(start); window > 0 {
+				progress = float64(now.Sub(start)) / float64(window)
+			}
+			if progress > p.RampStart {
+				rampProgress := 1.0
+				if p.RampStart < 1 {
+					rampProgress = math.Min(1, (progress-p.RampStart)/(1-p.RampStart))
+				}
+				acceptable = p.IdealGwei + (p.CeilingGwei-p.IdealGwei)*rampProgress
+			}
+		}
+	}
+
+	// The ceiling is always a hard limit
+	if p.CeilingGwei > 0 {
+		acceptable = math.Min(acceptable, p.CeilingGwei)
+	}
+	return acceptable
+
+}
+
+// Check a suggested max fee against the policy
+func (p GasPolicy) Evaluate(maxFeeWei *big.Int, start time.Time, deadline time.Time) GasPolicyDecision {
+	now := time.Now()
+	suggested := eth.WeiToGwei(maxFeeWei)
+	acceptable := p.GetAcceptableMaxFee(start, deadline, now)
+	return GasPolicyDecision{
+		Task:           p.Task,
+		Time:           now,
+		SuggestedGwei:  suggested,
+		AcceptableGwei: acceptable,
+		IdealGwei:      p.IdealGwei,
+		CeilingGwei:    p.CeilingGwei,
+		Deadline:       deadline,
+		Send:           suggested < acceptable,
+	}
+}
+
+// Log the outcome of a gas policy check
+func (d GasPolicyDecision) Log(logger log.ColorLogger) {
+	ceiling := "no ceiling"
+	if d.CeilingGwei > 0 {
+		ceiling = formatGwei(d.CeilingGwei) + " ceiling"
+	}
+	logger.Printlnf("Gas policy for %s: %s ideal, %s, currently accepting %s.", d.Task, formatGwei(d.IdealGwei), ceiling, formatGwei(d.AcceptableGwei))
+	if !d.Deadline.IsZero() {
+		if remaining := time.Until(d.Deadline); remaining > 0 {
+			logger.Printlnf("Time until the deadline: %s", remaining.Round(time.Second))
+		} else {
+			logger.Println("The deadline has been reached.")
+		}
+	}
+	if d.Send {
+		return
+	}
+	logger.Printlnf("Current network gas price is %.2f Gwei, which is not lower than the acceptable max fee of %s. Aborting the transaction.", d.SuggestedGwei, formatGwei(d.AcceptableGwei))
+}
+
+// Format a max fee in gwei, including unlimited ones
+func formatGwei(value float64) string {
+	if math.IsInf(value, 1) {
+		return "any max fee"
+	}
+	return fmt.Sprintf("%.2f Gwei", value)
+}
+
+// Keeps the latest gas policy decision of each task so it can be reported in the metrics
+type GasPolicyTracker struct {
+	decisions map[string]GasPolicyDecision
+	lock      *sync.Mutex
+}
+
+// Create a new gas policy tracker
+func NewGasPolicyTracker() *GasPolicyTracker {
+	return &GasPolicyTracker{
+		decisions: map[string]GasPolicyDecision{},
+		lock:      &sync.Mutex{},
+	}
+}
+
+// Record a task's latest decision
+func (t *GasPolicyTracker) Record(decision GasPolicyDecision) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.decisions[decision.Task] = decision
+}
+
+// Get the latest decision of each task, sorted by task
+func (t *GasPolicyTracker) GetDecisions() []GasPolicyDecision {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	decisions := make([]GasPolicyDecision, 0, len(t.decisions))
+	for _, decision := range t.decisions {
+		decisions = append(decisions, decision)
+	}
+	sort.Slice(decisions, func(i, j int) bool {
+		return decisions[i].Task < decisions[j].Task
+	})
+	return decisions
+}
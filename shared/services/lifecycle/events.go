@@ -0,0 +1,122 @@
+package lifecycle
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/services/state"
+)
+
+// A minipool moving from one lifecycle stage to another
+type Transition struct {
+	Minipool common.Address `json:"minipool"`
+	From     Stage          `json:"from"`
+	To       Stage          `json:"to"`
+	Block    uint64         `json:"block"`
+	Time     time.Time      `json:"time"`
+}
+
+// Records the lifecycle transitions of a node's minipools in a local log file, one JSON transition per line
+type EventLog struct {
+	path   string
+	stages map[common.Address]Stage
+	lock   sync.Mutex
+}
+
+// Open the event log at the given path, loading the last known stage of each minipool from it
+func NewEventLog(path string) (*EventLog, error) {
+	transitions, err := ReadTransitions(path)
+	if err != nil {
+		return nil, err
+	}
+	stages := map[common.Address]Stage{}
+	for _, transition := range transitions {
+		stages[transition.Minipool] = transition.To
+	}
+	return &EventLog{
+		path:   path,
+		stages: stages,
+	}, nil
+}
+
+// Record the transitions of a node's minipools since the last update, returning the new ones
+func (l *EventLog) Update(state *state.NetworkState, nodeAddress common.Address) ([]Transition, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Find the minipools whose stage changed
+	transitions := []Transition{}
+	blockTime := GetStateTime(state)
+	for _, stage := range GetNodeStages(state, nodeAddress) {
+		previous := l.stages[stage.Address]
+		if previous == stage.Stage {
+			continue
+		}
+		transitions = append(transitions, Transition{
+			Minipool: stage.Address,
+			From:     previous,
+			To:       stage.Stage,
+			Block:    state.ElBlockNumber,
+			Time:     blockTime,
+		})
+	}
+	if len(transitions) == 0 {
+		return transitions, nil
+	}
+
+	// Append them to the log
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return nil, fmt.Errorf("error creating minipool event log directory: %w", err)
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening minipool event log: %w", err)
+	}
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	for _, transition := range transitions {
+		if err := encoder.Encode(transition); err != nil {
+			return nil, fmt.Errorf("error writing minipool event log: %w", err)
+		}
+		l.stages[transition.Minipool] = transition.To
+	}
+	return transitions, nil
+}
+
+// Read the transitions in an event log, oldest first; a missing log has no transitions
+func ReadTransitions(path string) ([]Transition, error) {
+	transitions := []Transition{}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return transitions, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening minipool event log: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var transition Transition
+		if err := json.Unmarshal(line, &transition); err != nil {
+			return nil, fmt.Errorf("error reading minipool event log: %w", err)
+		}
+		transitions = append(transitions, transition)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading minipool event log: %w", err)
+	}
+	return transitions, nil
+}
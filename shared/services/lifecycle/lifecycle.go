@@ -0,0 +1,162 @@
+package lifecycle
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/types"
+	rpstate "github.com/Seb369888/poolsea-go/utils/state"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/state"
+)
+
+// The stages of a minipool's lifecycle
+type Stage string
+
+const (
+	Stage_Unknown              Stage = ""
+	Stage_Initialized          Stage = "initialized"
+	Stage_ScrubWindow          Stage = "scrubWindow"
+	Stage_Prelaunch            Stage = "prelaunch"
+	Stage_Staking              Stage = "staking"
+	Stage_BondReductionPending Stage = "bondReductionPending"
+	Stage_Exiting              Stage = "exiting"
+	Stage_Withdrawable         Stage = "withdrawable"
+	Stage_Finalized            Stage = "finalized"
+	Stage_Dissolved            Stage = "dissolved"
+)
+
+// All of the stages, in lifecycle order
+var Stages = []Stage{
+	Stage_Initialized,
+	Stage_ScrubWindow,
+	Stage_Prelaunch,
+	Stage_Staking,
+	Stage_BondReductionPending,
+	Stage_Exiting,
+	Stage_Withdrawable,
+	Stage_Finalized,
+	Stage_Dissolved,
+}
+
+// Where a minipool is in its lifecycle
+type MinipoolStage struct {
+	Address common.Address `json:"address"`
+	Stage   Stage          `json:"stage"`
+
+	// When the minipool can move on from a waiting period (the scrub window or the bond reduction wait), or zero if it isn't in one
+	WaitUntil time.Time `json:"waitUntil"`
+
+	// The minipool details the stage was derived from
+	Details *rpstate.NativeMinipoolDetails `json:"-"`
+}
+
+// Get the time of the block a network state was taken at
+func GetStateTime(state *state.NetworkState) time.Time {
+	return time.Unix(int64(state.BeaconConfig.GenesisTime+state.BeaconSlotNumber*state.BeaconConfig.SecondsPerSlot), 0)
+}
+
+// Get the lifecycle stage of a minipool in a network state
+func GetStage(mpd *rpstate.NativeMinipoolDetails, state *state.NetworkState) MinipoolStage {
+	stage := MinipoolStage{
+		Address: mpd.MinipoolAddress,
+		Details: mpd,
+	}
+	blockTime := GetStateTime(state)
+
+	// A finalized minipool keeps its last status, so this comes first
+	if mpd.Finalised {
+		stage.Stage = Stage_Finalized
+		return stage
+	}
+
+	switch mpd.Status {
+	case types.Initialized:
+		stage.Stage = Stage_Initialized
+
+	case types.Prelaunch:
+		// Vacant minipools wait for the promotion scrub period instead of the normal one
+		scrubPeriod := state.NetworkDetails.ScrubPeriod
+		if mpd.IsVacant {
+			scrubPeriod = state.NetworkDetails.PromotionScrubPeriod
+		}
+		scrubEnd := time.Unix(mpd.StatusTime.Int64(), 0).Add(scrubPeriod)
+		if blockTime.Before(scrubEnd) {
+			stage.Stage = Stage_ScrubWindow
+			stage.WaitUntil = scrubEnd
+		} else {
+			stage.Stage = Stage_Prelaunch
+		}
+
+	case types.Staking:
+		stage.Stage = Stage_Staking
+		if isExiting(state.ValidatorDetails[mpd.Pubkey]) {
+			stage.Stage = Stage_Exiting
+		} else if mpd.ReduceBondTime != nil && mpd.ReduceBondTime.Sign() > 0 && !mpd.ReduceBondCancelled {
+			// A bond reduction has begun and its window hasn't closed
+			reduceBondTime := time.Unix(mpd.ReduceBondTime.Int64(), 0)
+			windowStart := state.NetworkDetails.BondReductionWindowStart
+			windowEnd := windowStart + state.NetworkDetails.BondReductionWindowLength
+			if blockTime.Sub(reduceBondTime) < windowEnd {
+				stage.Stage = Stage_BondReductionPending
+				if blockTime.Sub(reduceBondTime) < windowStart {
+					stage.WaitUntil = reduceBondTime.Add(windowStart)
+				}
+			}
+		}
+
+	case types.Withdrawable:
+		stage.Stage = Stage_Withdrawable
+
+	case types.Dissolved:
+		stage.Stage = Stage_Dissolved
+	}
+
+	return stage
+}
+
+// Get the lifecycle stages of a node's minipools, sorted by address
+func GetNodeStages(state *state.NetworkState, nodeAddress common.Address) []MinipoolStage {
+	mpds := state.MinipoolDetailsByNode[nodeAddress]
+	stages := make([]MinipoolStage, len(mpds))
+	for i, mpd := range mpds {
+		stages[i] = GetStage(mpd, state)
+	}
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].Address.Hex() < stages[j].Address.Hex()
+	})
+	return stages
+}
+
+// Get a node's minipools that are in one of the given stages
+func GetNodeMinipoolsInStage(state *state.NetworkState, nodeAddress common.Address, stages ...Stage) []MinipoolStage {
+	matches := []MinipoolStage{}
+	for _, stage := range GetNodeStages(state, nodeAddress) {
+		for _, target := range stages {
+			if stage.Stage == target {
+				matches = append(matches, stage)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// Check if a validator has begun exiting the Beacon Chain
+func isExiting(status beacon.ValidatorStatus) bool {
+	if !status.Exists {
+		return false
+	}
+	switch status.Status {
+	case beacon.ValidatorState_ActiveExiting,
+		beacon.ValidatorState_ActiveSlashed,
+		beacon.ValidatorState_ExitedUnslashed,
+		beacon.ValidatorState_ExitedSlashed,
+		beacon.ValidatorState_WithdrawalPossible,
+		beacon.ValidatorState_WithdrawalDone:
+		return true
+	}
+	return false
+}
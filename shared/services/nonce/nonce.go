@@ -0,0 +1,251 @@
+package nonce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/utils/log"
+)
+
+// How long a nonce that was reserved but never used is held before it can be given out again
+const reservationTimeout time.Duration = 5 * time.Minute
+
+// How long a sent transaction that never shows up in the pending pool is tracked before its nonce can be given out again
+const sentTimeout time.Duration = 30 * time.Minute
+
+// The context key that marks nonce lookups that should reserve the nonce
+type reserveKey struct{}
+
+// Mark the transactions sent with a context as needing a reserved nonce
+func WithReservation(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, reserveKey{}, true)
+}
+
+// Check if the nonce lookups made with a context should reserve the nonce
+func IsReserving(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	reserving, _ := ctx.Value(reserveKey{}).(bool)
+	return reserving
+}
+
+// A nonce given to a transaction
+type reservation struct {
+	Nonce uint64    `json:"nonce"`
+	Owner int       `json:"owner"`
+	Sent  bool      `json:"sent"`
+	Time  time.Time `json:"time"`
+}
+
+// The nonces given out for an account
+type accountState struct {
+	LastPendingNonce uint64        `json:"lastPendingNonce"`
+	Reservations     []reservation `json:"reservations"`
+}
+
+// Allocates nonces to the processes sending transactions from the same accounts.
+// The allocations are kept in a file guarded by a lock file, so the daemons and API calls all see each other's transactions.
+type Manager struct {
+	path     string
+	lockPath string
+	log      log.ColorLogger
+}
+
+// Create a nonce manager that stores its allocations at the given path
+func NewManager(path string, logger log.ColorLogger) *Manager {
+	return &Manager{
+		path:     path,
+		lockPath: path + ".lock",
+		log:      logger,
+	}
+}
+
+// Reserve the next nonce for an account.
+// getPendingNonce must return the account's pending nonce from the Execution client.
+func (m *Manager) Reserve(address common.Address, getPendingNonce func() (uint64, error)) (uint64, error) {
+	var nonce uint64
+	err := m.update(func(accounts map[common.Address]*accountState) error {
+		pendingNonce, err := getPendingNonce()
+		if err != nil {
+			return err
+		}
+
+		account := getAccount(accounts, address)
+		m.checkForExternalTransactions(address, account, pendingNonce)
+		account.prune(pendingNonce)
+
+		// Use the lowest nonce that isn't taken, which fills the gaps left by released reservations
+		nonce = pendingNonce
+		for account.isTaken(nonce) {
+			nonce++
+		}
+		account.Reservations = append(account.Reservations, reservation{
+			Nonce: nonce,
+			Owner: os.Getpid(),
+			Time:  time.Now(),
+		})
+		account.LastPendingNonce = pendingNonce
+		return nil
+	})
+	return nonce, err
+}
+
+// Record that a transaction with the nonce was sent; this also tracks nonces that were set manually instead of reserved
+func (m *Manager) MarkSent(address common.Address, nonce uint64) error {
+	return m.update(func(accounts map[common.Address]*accountState) error {
+		account := getAccount(accounts, address)
+		for i := range account.Reservations {
+			if account.Reservations[i].Nonce == nonce {
+				account.Reservations[i].Sent = true
+				account.Reservations[i].Time = time.Now()
+				return nil
+			}
+		}
+		account.Reservations = append(account.Reservations, reservation{
+			Nonce: nonce,
+			Owner: os.Getpid(),
+			Sent:  true,
+			Time:  time.Now(),
+		})
+		return nil
+	})
+}
+
+// Release a nonce this process reserved but didn't use, so it can be given to the next transaction
+func (m *Manager) Release(address common.Address, nonce uint64) error {
+	return m.update(func(accounts map[common.Address]*accountState) error {
+		account := getAccount(accounts, address)
+		reservations := []reservation{}
+		for _, r := range account.Reservations {
+			if r.Nonce != nonce || r.Sent || r.Owner != os.Getpid() {
+				reservations = append(reservations, r)
+			}
+		}
+		account.Reservations = reservations
+		return nil
+	})
+}
+
+// Log the transactions from the account that were sent outside of the Smartnode since the last reservation
+func (m *Manager) checkForExternalTransactions(address common.Address, account *accountState, pendingNonce uint64) {
+	if account.LastPendingNonce == 0 || pendingNonce <= account.LastPendingNonce {
+		return
+	}
+	external := 0
+	for nonce := account.LastPendingNonce; nonce < pendingNonce; nonce++ {
+		if !account.isTaken(nonce) {
+			external++
+		}
+	}
+	if external > 0 {
+		m.log.Printlnf("NOTE: %d transaction(s) from %s were sent outside of the Smartnode since its last transaction.", external, address.Hex())
+	}
+}
+
+// Remove the reservations that are finished or abandoned
+func (a *accountState) prune(pendingNonce uint64) {
+	reservations := []reservation{}
+	for _, r := range a.Reservations {
+		if r.Nonce < pendingNonce {
+			// It's been used, either by this transaction or an external one
+			continue
+		}
+		if !r.Sent && time.Since(r.Time) > reservationTimeout {
+			continue
+		}
+		if r.Sent && time.Since(r.Time) > sentTimeout {
+			// The transaction was dropped from the pending pool
+			continue
+		}
+		reservations = append(reservations, r)
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].Nonce < reservations[j].Nonce
+	})
+	a.Reservations = reservations
+}
+
+// Check if a nonce has been given out
+func (a *accountState) isTaken(nonce uint64) bool {
+	for _, r := range a.Reservations {
+		if r.Nonce == nonce {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the state of an account, creating it if it doesn't exist yet
+func getAccount(accounts map[common.Address]*accountState, address common.Address) *accountState {
+	account, exists := accounts[address]
+	if !exists {
+		account = &accountState{
+			Reservations: []reservation{},
+		}
+		accounts[address] = account
+	}
+	return account
+}
+
+// Load the allocations, modify them and save them while holding the lock
+func (m *Manager) update(modify func(accounts map[common.Address]*accountState) error) error {
+
+	// Take the lock
+	if err := os.MkdirAll(filepath.Dir(m.path), 0700); err != nil {
+		return fmt.Errorf("error creating nonce manager directory: %w", err)
+	}
+	lockFile, err := os.OpenFile(m.lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("error opening nonce manager lock file: %w", err)
+	}
+	defer lockFile.Close()
+	if err := syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("error locking nonce manager: %w", err)
+	}
+	defer syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+
+	// Load the allocations; a missing or corrupt file just means nothing is being tracked
+	accounts := map[common.Address]*accountState{}
+	bytes, err := os.ReadFile(m.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error reading nonce manager state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(bytes, &accounts); err != nil {
+			m.log.Printlnf("WARNING: nonce manager state was corrupt (%s), starting over.", err.Error())
+			accounts = map[common.Address]*accountState{}
+		}
+	}
+
+	if err := modify(accounts); err != nil {
+		return err
+	}
+
+	// Save them
+	bytes, err = json.Marshal(accounts)
+	if err != nil {
+		return fmt.Errorf("error serializing nonce manager state: %w", err)
+	}
+	tempPath := m.path + ".tmp"
+	if err := os.WriteFile(tempPath, bytes, 0600); err != nil {
+		return fmt.Errorf("error writing nonce manager state: %w", err)
+	}
+	if err := os.Rename(tempPath, m.path); err != nil {
+		return fmt.Errorf("error writing nonce manager state: %w", err)
+	}
+	return nil
+
+}
@@ -0,0 +1,204 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The kinds of events the daemons send notifications for
+type EventType string
+
+const (
+	EventType_MinipoolStaked        EventType = "minipoolStaked"
+	EventType_BalanceDistributed    EventType = "balanceDistributed"
+	EventType_BondReduced           EventType = "bondReduced"
+	EventType_RewardsTreeDownloaded EventType = "rewardsTreeDownloaded"
+	EventType_RewardsTreeSubmitted  EventType = "rewardsTreeSubmitted"
+	EventType_RepeatedErrors        EventType = "repeatedErrors"
+	EventType_ProposalOpened        EventType = "proposalOpened"
+	EventType_ProposalExpiring      EventType = "proposalExpiring"
+	EventType_ProposalSucceeded     EventType = "proposalSucceeded"
+	EventType_ProposalExecuted      EventType = "proposalExecuted"
+	EventType_AutoRestakeNeeded     EventType = "autoRestakeNeeded"
+	EventType_AlertFiring           EventType = "alertFiring"
+	EventType_AlertResolved         EventType = "alertResolved"
+)
+
+// The format of the times in event fields
+const timeFormat = time.RFC1123
+
+// Something a daemon did that's worth telling the operator about
+type Event struct {
+	Type EventType `json:"type"`
+
+	// The daemon that raised the event
+	Source string `json:"source"`
+
+	Time time.Time `json:"time"`
+
+	// The event's details, which templates refer to by name (e.g. {{.Fields.Minipool}})
+	Fields map[string]string `json:"fields"`
+}
+
+// Create an event with the given fields
+func newEvent(eventType EventType, fields map[string]string) Event {
+	return Event{
+		Type:   eventType,
+		Time:   time.Now(),
+		Fields: fields,
+	}
+}
+
+// A minipool was staked
+func MinipoolStaked(minipool common.Address, txHash common.Hash) Event {
+	return newEvent(EventType_MinipoolStaked, map[string]string{
+		"Minipool": minipool.Hex(),
+		"TxHash":   txHash.Hex(),
+	})
+}
+
+// A minipool's balance was distributed
+func BalanceDistributed(minipool common.Address, amount float64, txHash common.Hash) Event {
+	return newEvent(EventType_BalanceDistributed, map[string]string{
+		"Minipool": minipool.Hex(),
+		"Amount":   fmt.Sprintf("%.6f", amount),
+		"TxHash":   txHash.Hex(),
+	})
+}
+
+// A minipool's bond was reduced
+func BondReduced(minipool common.Address, txHash common.Hash) Event {
+	return newEvent(EventType_BondReduced, map[string]string{
+		"Minipool": minipool.Hex(),
+		"TxHash":   txHash.Hex(),
+	})
+}
+
+// The rewards tree for an interval was downloaded
+func RewardsTreeDownloaded(interval uint64) Event {
+	return newEvent(EventType_RewardsTreeDownloaded, map[string]string{
+		"Interval": fmt.Sprint(interval),
+	})
+}
+
+// The rewards tree for an interval was submitted by the Oracle DAO node
+func RewardsTreeSubmitted(interval uint64, txHash common.Hash) Event {
+	return newEvent(EventType_RewardsTreeSubmitted, map[string]string{
+		"Interval": fmt.Sprint(interval),
+		"TxHash":   txHash.Hex(),
+	})
+}
+
+// A task has failed several times in a row
+func RepeatedErrors(task string, count int, err error) Event {
+	return newEvent(EventType_RepeatedErrors, map[string]string{
+		"Task":  task,
+		"Count": fmt.Sprint(count),
+		"Error": err.Error(),
+	})
+}
+
+// An oracle DAO proposal was opened for voting
+func ProposalOpened(id uint64, message string, proposer common.Address, endTime time.Time) Event {
+	return newEvent(EventType_ProposalOpened, map[string]string{
+		"ProposalID": fmt.Sprint(id),
+		"Message":    message,
+		"Proposer":   proposer.Hex(),
+		"Deadline":   endTime.UTC().Format(timeFormat),
+	})
+}
+
+// Voting on an oracle DAO proposal is about to end and the node hasn't voted
+func ProposalExpiring(id uint64, message string, endTime time.Time) Event {
+	return newEvent(EventType_ProposalExpiring, map[string]string{
+		"ProposalID": fmt.Sprint(id),
+		"Message":    message,
+		"Deadline":   endTime.UTC().Format(timeFormat),
+	})
+}
+
+// An oracle DAO proposal passed and can be executed
+func ProposalSucceeded(id uint64, message string, votesFor float64, votesRequired float64, expiryTime time.Time) Event {
+	return newEvent(EventType_ProposalSucceeded, map[string]string{
+		"ProposalID":    fmt.Sprint(id),
+		"Message":       message,
+		"VotesFor":      fmt.Sprintf("%.2f", votesFor),
+		"VotesRequired": fmt.Sprintf("%.2f", votesRequired),
+		"Deadline":      expiryTime.UTC().Format(timeFormat),
+	})
+}
+
+// An oracle DAO proposal was executed by the node
+func ProposalExecuted(id uint64, message string, txHash common.Hash) Event {
+	return newEvent(EventType_ProposalExecuted, map[string]string{
+		"ProposalID": fmt.Sprint(id),
+		"Message":    message,
+		"TxHash":     txHash.Hex(),
+	})
+}
+
+// The node's unclaimed rewards are worth claiming
+func AutoRestakeNeeded(intervals int, rpl float64, eth float64, value float64) Event {
+	return newEvent(EventType_AutoRestakeNeeded, map[string]string{
+		"Intervals": fmt.Sprint(intervals),
+		"RPL":       fmt.Sprintf("%.6f", rpl),
+		"ETH":       fmt.Sprintf("%.6f", eth),
+		"Value":     fmt.Sprintf("%.6f", value),
+	})
+}
+
+// An alert rule found a problem with the node or one of its minipools
+func AlertFiring(rule string, subject string, severity string, summary string) Event {
+	return newEvent(EventType_AlertFiring, map[string]string{
+		"Rule":     rule,
+		"Subject":  subject,
+		"Severity": severity,
+		"Summary":  summary,
+	})
+}
+
+// A problem an alert rule found has been resolved
+func AlertResolved(rule string, subject string, summary string, firedAt time.Time) Event {
+	return newEvent(EventType_AlertResolved, map[string]string{
+		"Rule":    rule,
+		"Subject": subject,
+		"Summary": summary,
+		"FiredAt": firedAt.UTC().Format(timeFormat),
+	})
+}
+
+// The title of each type of event
+var eventTitles = map[EventType]string{
+	EventType_MinipoolStaked:        "Minipool staked",
+	EventType_BalanceDistributed:    "Minipool balance distributed",
+	EventType_BondReduced:           "Minipool bond reduced",
+	EventType_RewardsTreeDownloaded: "Rewards tree downloaded",
+	EventType_RewardsTreeSubmitted:  "Rewards tree submitted",
+	EventType_RepeatedErrors:        "Repeated errors",
+	EventType_ProposalOpened:        "Oracle DAO proposal opened",
+	EventType_ProposalExpiring:      "Oracle DAO proposal vote ending",
+	EventType_ProposalSucceeded:     "Oracle DAO proposal passed",
+	EventType_ProposalExecuted:      "Oracle DAO proposal executed",
+	EventType_AutoRestakeNeeded:     "Rewards ready to claim",
+	EventType_AlertFiring:           "Alert",
+	EventType_AlertResolved:         "Alert resolved",
+}
+
+// The default message template of each type of event
+var defaultTemplates = map[EventType]string{
+	EventType_MinipoolStaked:        "Minipool {{.Fields.Minipool}} was staked (transaction {{.Fields.TxHash}}).",
+	EventType_BalanceDistributed:    "The {{.Fields.Amount}} ETH balance of minipool {{.Fields.Minipool}} was distributed (transaction {{.Fields.TxHash}}).",
+	EventType_BondReduced:           "The bond of minipool {{.Fields.Minipool}} was reduced (transaction {{.Fields.TxHash}}).",
+	EventType_RewardsTreeDownloaded: "The rewards tree for interval {{.Fields.Interval}} was downloaded.",
+	EventType_RewardsTreeSubmitted:  "The rewards tree for interval {{.Fields.Interval}} was submitted (transaction {{.Fields.TxHash}}).",
+	EventType_RepeatedErrors:        "The {{.Source}} daemon's {{.Fields.Task}} task has failed {{.Fields.Count}} times in a row. Latest error: {{.Fields.Error}}",
+	EventType_ProposalOpened:        "Oracle DAO proposal {{.Fields.ProposalID}} from {{.Fields.Proposer}} is open for voting until {{.Fields.Deadline}}: {{.Fields.Message}}",
+	EventType_ProposalExpiring:      "Voting on oracle DAO proposal {{.Fields.ProposalID}} ends at {{.Fields.Deadline}} and this node hasn't voted yet: {{.Fields.Message}}",
+	EventType_ProposalSucceeded:     "Oracle DAO proposal {{.Fields.ProposalID}} passed with {{.Fields.VotesFor}} votes ({{.Fields.VotesRequired}} required) and can be executed until {{.Fields.Deadline}}: {{.Fields.Message}}",
+	EventType_ProposalExecuted:      "Oracle DAO proposal {{.Fields.ProposalID}} was executed (transaction {{.Fields.TxHash}}): {{.Fields.Message}}",
+	EventType_AutoRestakeNeeded:     "Rewards from {{.Fields.Intervals}} interval(s) are ready to claim: {{.Fields.RPL}} RPL and {{.Fields.ETH}} ETH (worth {{.Fields.Value}} ETH in total).",
+	EventType_AlertFiring:           "[{{.Fields.Severity}}] {{.Fields.Rule}}: {{.Fields.Summary}}",
+	EventType_AlertResolved:         "[resolved] {{.Fields.Rule}} (firing since {{.Fields.FiredAt}}): {{.Fields.Summary}}",
+}
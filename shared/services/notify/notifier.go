@@ -0,0 +1,190 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// How many notifications can wait for each target before new ones are dropped
+const queueSize = 100
+
+// Settings for a notifier
+type NotifierSettings struct {
+	// The daemon sending the notifications
+	Source string
+
+	Targets []Target
+
+	// The most notifications to send to each target per minute
+	RateLimit uint64
+
+	// How many times in a row a task has to fail before it's reported; 0 disables error reports
+	ErrorThreshold uint64
+
+	// A JSON file of message templates by event type that replace the default ones; the defaults are used if it doesn't exist
+	TemplatesPath string
+}
+
+// Renders daemon events and sends them to the targets in the background, respecting each target's rate limit
+type Notifier struct {
+	source         string
+	queues         []*targetQueue
+	templates      map[EventType]*template.Template
+	errorThreshold uint64
+	errorCounts    map[string]uint64
+	lock           sync.Mutex
+}
+
+// A target and the notifications waiting to be sent to it
+type targetQueue struct {
+	target   Target
+	interval time.Duration
+	pending  chan Notification
+}
+
+// Create a notifier and start sending to its targets. A notifier without targets is nil, and a nil notifier does nothing.
+func NewNotifier(settings NotifierSettings) (*Notifier, error) {
+	if len(settings.Targets) == 0 {
+		return nil, nil
+	}
+
+	// Load the templates
+	templates := map[EventType]*template.Template{}
+	customTemplates, err := loadTemplates(settings.TemplatesPath)
+	if err != nil {
+		return nil, err
+	}
+	for eventType, text := range defaultTemplates {
+		if custom, exists := customTemplates[eventType]; exists {
+			text = custom
+		}
+		templates[eventType], err = template.New(string(eventType)).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing notification template for %s: %w", eventType, err)
+		}
+	}
+
+	// Start a sender for each target
+	interval := time.Duration(0)
+	if settings.RateLimit > 0 {
+		interval = time.Minute / time.Duration(settings.RateLimit)
+	}
+	n := &Notifier{
+		source:         settings.Source,
+		templates:      templates,
+		errorThreshold: settings.ErrorThreshold,
+		errorCounts:    map[string]uint64{},
+	}
+	for _, target := range settings.Targets {
+		queue := &targetQueue{
+			target:   target,
+			interval: interval,
+			pending:  make(chan Notification, queueSize),
+		}
+		n.queues = append(n.queues, queue)
+		go queue.run()
+	}
+	return n, nil
+}
+
+// Send an event to every target without waiting for it to be delivered
+func (n *Notifier) Notify(event Event) {
+	if n == nil {
+		return
+	}
+	event.Source = n.source
+
+	// Render the message
+	var message strings.Builder
+	if err := n.templates[event.Type].Execute(&message, event); err != nil {
+		logf("Error rendering notification for %s: %s", event.Type, err.Error())
+		return
+	}
+	notification := Notification{
+		Title:   eventTitles[event.Type],
+		Message: message.String(),
+		Event:   event,
+	}
+
+	// Queue it
+	for _, queue := range n.queues {
+		select {
+		case queue.pending <- notification:
+		default:
+			logf("Dropped a %s notification because too many are waiting to be sent to the %s target.", event.Type, queue.target.GetName())
+		}
+	}
+}
+
+// Track the result of a task, sending a notification once it has failed enough times in a row. Returns the task's error.
+func (n *Notifier) TrackTask(task string, err error) error {
+	if n == nil || n.errorThreshold == 0 {
+		return err
+	}
+
+	n.lock.Lock()
+	if err == nil {
+		delete(n.errorCounts, task)
+		n.lock.Unlock()
+		return nil
+	}
+	n.errorCounts[task]++
+	count := n.errorCounts[task]
+	n.lock.Unlock()
+
+	// Only notify when the threshold is reached so a task that keeps failing doesn't spam the targets
+	if count == n.errorThreshold {
+		n.Notify(RepeatedErrors(task, int(count), err))
+	}
+	return err
+}
+
+// Send the queued notifications to the target, no faster than its rate limit
+func (q *targetQueue) run() {
+	for notification := range q.pending {
+		err := q.target.Send(notification)
+
+		// Wait and try once more if the target says to slow down
+		var rateLimited *RateLimitedError
+		if errors.As(err, &rateLimited) {
+			time.Sleep(rateLimited.RetryAfter)
+			err = q.target.Send(notification)
+		}
+		if err != nil {
+			logf("Error sending %s notification to the %s target: %s", notification.Event.Type, q.target.GetName(), err.Error())
+		}
+		time.Sleep(q.interval)
+	}
+}
+
+// Load the custom message templates from a file
+func loadTemplates(path string) (map[EventType]string, error) {
+	templates := map[EventType]string{}
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return templates, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading notification templates: %w", err)
+	}
+	if err := json.Unmarshal(bytes, &templates); err != nil {
+		return nil, fmt.Errorf("error decoding notification templates: %w", err)
+	}
+	for eventType := range templates {
+		if _, exists := defaultTemplates[eventType]; !exists {
+			return nil, fmt.Errorf("notification templates file has a template for unknown event type [%s]", eventType)
+		}
+	}
+	return templates, nil
+}
+
+// Log a notifier message
+func logf(format string, v ...interface{}) {
+	fmt.Printf("[Notifications] "+format+"\n", v...)
+}
@@ -0,0 +1,170 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How long to wait for a target to respond
+const targetTimeout = 10 * time.Second
+
+// A rendered notification
+type Notification struct {
+	Title   string
+	Message string
+	Event   Event
+}
+
+// Somewhere notifications are sent
+type Target interface {
+	// The target's name, for logging
+	GetName() string
+
+	// Send a notification
+	Send(notification Notification) error
+}
+
+// Returned by a target that was told to slow down
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+}
+
+// Post a request to a target and check its response
+func post(client *http.Client, request *http.Request) error {
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter := time.Minute
+		if seconds, err := strconv.ParseFloat(response.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds * float64(time.Second))
+		}
+		return &RateLimitedError{RetryAfter: retryAfter}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("returned status %d: %s", response.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// Post JSON to a URL
+func postJson(client *http.Client, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error encoding notification: %w", err)
+	}
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return post(client, request)
+}
+
+// Sends notifications to a Discord webhook, or any webhook that accepts Slack's message format
+type ChatWebhookTarget struct {
+	url    string
+	client *http.Client
+}
+
+// Create a target for a Discord or Slack-compatible webhook
+func NewChatWebhookTarget(url string) *ChatWebhookTarget {
+	return &ChatWebhookTarget{
+		url:    url,
+		client: &http.Client{Timeout: targetTimeout},
+	}
+}
+
+func (t *ChatWebhookTarget) GetName() string {
+	return "chat webhook"
+}
+
+func (t *ChatWebhookTarget) Send(notification Notification) error {
+	// Discord reads `content` and Slack reads `text`; each ignores the other
+	return postJson(t.client, t.url, map[string]string{
+		"username": "Poolsea",
+		"content":  fmt.Sprintf("**%s**\n%s", notification.Title, notification.Message),
+		"text":     fmt.Sprintf("*%s*\n%s", notification.Title, notification.Message),
+	})
+}
+
+// Sends notifications to a webhook as JSON objects with the event's details
+type JsonWebhookTarget struct {
+	url    string
+	client *http.Client
+}
+
+// Create a target for a generic JSON webhook
+func NewJsonWebhookTarget(url string) *JsonWebhookTarget {
+	return &JsonWebhookTarget{
+		url:    url,
+		client: &http.Client{Timeout: targetTimeout},
+	}
+}
+
+func (t *JsonWebhookTarget) GetName() string {
+	return "JSON webhook"
+}
+
+func (t *JsonWebhookTarget) Send(notification Notification) error {
+	return postJson(t.client, t.url, struct {
+		Event
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	}{
+		Event:   notification.Event,
+		Title:   notification.Title,
+		Message: notification.Message,
+	})
+}
+
+// Sends notifications to an ntfy topic URL
+type NtfyTarget struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// Create a target for an ntfy topic, with an optional access token
+func NewNtfyTarget(url string, token string) *NtfyTarget {
+	return &NtfyTarget{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: targetTimeout},
+	}
+}
+
+func (t *NtfyTarget) GetName() string {
+	return "ntfy"
+}
+
+func (t *NtfyTarget) Send(notification Notification) error {
+	request, err := http.NewRequest(http.MethodPost, t.url, strings.NewReader(notification.Message))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Title", notification.Title)
+	request.Header.Set("Tags", string(notification.Event.Type))
+	if notification.Event.Type == EventType_RepeatedErrors ||
+		(notification.Event.Type == EventType_AlertFiring && notification.Event.Fields["Severity"] == "critical") {
+		request.Header.Set("Priority", "high")
+	}
+	if t.token != "" {
+		request.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return post(t.client, request)
+}
@@ -0,0 +1,57 @@
+package passwords
+
+import (
+	"fmt"
+	"os"
+)
+
+// Stores the password in a plaintext file
+type fileStore struct {
+	passwordPath string
+}
+
+// Create new file store
+func newFileStore(passwordPath string) *fileStore {
+	return &fileStore{
+		passwordPath: passwordPath,
+	}
+}
+
+// Check if the password file exists
+func (s *fileStore) isSet() bool {
+	_, err := os.ReadFile(s.passwordPath)
+	return (err == nil)
+}
+
+// Read the password from disk
+func (s *fileStore) load() (string, error) {
+	password, err := os.ReadFile(s.passwordPath)
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from disk: %w", err)
+	}
+	return string(password), nil
+}
+
+// Write the password to disk
+func (s *fileStore) store(password string) error {
+	if err := os.WriteFile(s.passwordPath, []byte(password), FileMode); err != nil {
+		return fmt.Errorf("Could not write password to disk: %w", err)
+	}
+	return nil
+}
+
+// Delete the password file
+func (s *fileStore) remove() error {
+
+	// Check if it exists
+	_, err := os.Stat(s.passwordPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error checking password file path: %w", err)
+	}
+
+	// Delete it
+	return os.Remove(s.passwordPath)
+
+}
@@ -0,0 +1,88 @@
+package passwords
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Config
+const (
+	secretToolCommand     string = "secret-tool"
+	keyringLabel          string = "Smartnode wallet password"
+	keyringApplicationKey string = "application"
+	keyringApplication    string = "rocketpool-smartnode"
+	keyringPathKey        string = "path"
+)
+
+// Stores the password in the OS keyring using the Secret Service D-Bus API (via libsecret's secret-tool)
+type keyringStore struct {
+	passwordPath string
+}
+
+// Create new keyring store.
+// The password path isn't written to; it's used as an attribute so multiple nodes on the same machine don't collide.
+func newKeyringStore(passwordPath string) *keyringStore {
+	return &keyringStore{
+		passwordPath: passwordPath,
+	}
+}
+
+// Check if the password is in the keyring
+func (s *keyringStore) isSet() bool {
+	password, err := s.load()
+	return (err == nil && password != "")
+}
+
+// Look up the password in the keyring
+func (s *keyringStore) load() (string, error) {
+	output, err := s.runSecretTool(nil, "lookup")
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from the keyring: %w", err)
+	}
+	if output == "" {
+		return "", fmt.Errorf("Could not read password from the keyring: no password is stored for %s", s.passwordPath)
+	}
+	return output, nil
+}
+
+// Store the password in the keyring
+func (s *keyringStore) store(password string) error {
+	if _, err := s.runSecretTool([]byte(password), "store", "--label="+keyringLabel); err != nil {
+		return fmt.Errorf("Could not write password to the keyring: %w", err)
+	}
+	return nil
+}
+
+// Remove the password from the keyring
+func (s *keyringStore) remove() error {
+	if _, err := s.runSecretTool(nil, "clear"); err != nil {
+		return fmt.Errorf("Could not remove password from the keyring: %w", err)
+	}
+	return nil
+}
+
+// Run secret-tool with the node's attributes appended to the arguments
+func (s *keyringStore) runSecretTool(input []byte, args ...string) (string, error) {
+
+	args = append(args, keyringApplicationKey, keyringApplication, keyringPathKey, s.passwordPath)
+	cmd := exec.Command(secretToolCommand, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errMessage := strings.TrimSpace(stderr.String()); errMessage != "" {
+			return "", fmt.Errorf("%w: %s", err, errMessage)
+		}
+		return "", err
+	}
+
+	// secret-tool only adds a trailing newline when writing to a terminal, but trim it in case
+	return strings.TrimSuffix(stdout.String(), "\n"), nil
+
+}
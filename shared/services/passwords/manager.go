@@ -3,7 +3,6 @@ package passwords
 import (
 	"errors"
 	"fmt"
-	"os"
 )
 
 // Config
@@ -12,36 +11,63 @@ const (
 	FileMode          = 0600
 )
 
+// A backend that the password can be stored in
+type passwordStore interface {
+	// Check if the password has been stored
+	isSet() bool
+
+	// Load the password
+	load() (string, error)
+
+	// Store the password
+	store(password string) error
+
+	// Remove the password
+	remove() error
+}
+
 // Password manager
 type PasswordManager struct {
-	passwordPath string
+	store passwordStore
 }
 
-// Create new password manager
+// Create new password manager that stores the password in a plaintext file
 func NewPasswordManager(passwordPath string) *PasswordManager {
 	return &PasswordManager{
-		passwordPath: passwordPath,
+		store: newFileStore(passwordPath),
 	}
 }
 
-// Check if the password has been set
-func (pm *PasswordManager) IsPasswordSet() bool {
-	_, err := os.ReadFile(pm.passwordPath)
-	return (err == nil)
+// Create new password manager that stores the password in the OS keyring via the Secret Service API
+func NewKeyringPasswordManager(passwordPath string) *PasswordManager {
+	return &PasswordManager{
+		store: newKeyringStore(passwordPath),
+	}
 }
 
-// Get the password
-func (pm *PasswordManager) GetPassword() (string, error) {
+// Create new password manager that stores the password in a credential sealed by systemd-creds
+func NewSystemdCredsPasswordManager(credentialPath string) *PasswordManager {
+	return &PasswordManager{
+		store: newSystemdCredsStore(credentialPath),
+	}
+}
 
-	// Read from disk
-	password, err := os.ReadFile(pm.passwordPath)
-	if err != nil {
-		return "", fmt.Errorf("Could not read password from disk: %w", err)
+// Create new password manager that only holds the password in memory.
+// The password is read from the environment at startup, or provided to the password agent listening on the socket.
+func NewMemoryPasswordManager(socketPath string) *PasswordManager {
+	return &PasswordManager{
+		store: newMemoryStore(socketPath),
 	}
+}
 
-	// Return
-	return string(password), nil
+// Check if the password has been set
+func (pm *PasswordManager) IsPasswordSet() bool {
+	return pm.store.isSet()
+}
 
+// Get the password
+func (pm *PasswordManager) GetPassword() (string, error) {
+	return pm.store.load()
 }
 
 // Set the password
@@ -57,29 +83,22 @@ func (pm *PasswordManager) SetPassword(password string) error {
 		return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
 	}
 
-	// Write to disk
-	if err := os.WriteFile(pm.passwordPath, []byte(password), FileMode); err != nil {
-		return fmt.Errorf("Could not write password to disk: %w", err)
-	}
-
-	// Return
-	return nil
+	// Store it
+	return pm.store.store(password)
 
 }
 
 // Delete the password
 func (pm *PasswordManager) DeletePassword() error {
+	return pm.store.remove()
+}
 
-	// Check if it exists
-	_, err := os.Stat(pm.passwordPath)
-	if os.IsNotExist(err) {
+// Start the password agent so other Smartnode processes can retrieve the password from this one.
+// This does nothing unless the password is only held in memory.
+func (pm *PasswordManager) StartAgent() error {
+	store, ok := pm.store.(*memoryStore)
+	if !ok {
 		return nil
-	} else if err != nil {
-		return fmt.Errorf("error checking password file path: %w", err)
 	}
-
-	// Delete it
-	err = os.Remove(pm.passwordPath)
-	return err
-
+	return store.startAgent()
 }
@@ -0,0 +1,223 @@
+package passwords
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// Config
+const (
+	PasswordEnvVar      string        = "ROCKETPOOL_WALLET_PASSWORD"
+	agentRequestTimeout time.Duration = 5 * time.Second
+
+	agentCommandGet   string = "get"
+	agentCommandSet   string = "set"
+	agentCommandClear string = "clear"
+)
+
+// A request sent to the password agent
+type agentRequest struct {
+	Command  string `json:"command"`
+	Password string `json:"password,omitempty"`
+}
+
+// A response from the password agent
+type agentResponse struct {
+	Password string `json:"password,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Holds the password in memory only.
+// One process (the node daemon) runs the agent, which listens on a unix socket; every other process asks the agent for the password.
+type memoryStore struct {
+	socketPath string
+	password   string
+	isAgent    bool
+	lock       sync.Mutex
+}
+
+// Create new memory store, taking the password from the environment if it was provided there
+func newMemoryStore(socketPath string) *memoryStore {
+	password := os.Getenv(PasswordEnvVar)
+	os.Unsetenv(PasswordEnvVar)
+	return &memoryStore{
+		socketPath: socketPath,
+		password:   password,
+	}
+}
+
+// Check if the password is available
+func (s *memoryStore) isSet() bool {
+	_, err := s.load()
+	return (err == nil)
+}
+
+// Get the password from memory, or from the agent if this process doesn't have it
+func (s *memoryStore) load() (string, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.password != "" {
+		return s.password, nil
+	}
+	if s.isAgent {
+		return "", errors.New("The password has not been provided to the password agent yet")
+	}
+
+	response, err := s.sendRequest(agentRequest{Command: agentCommandGet})
+	if err != nil {
+		return "", fmt.Errorf("Could not get password from the password agent: %w", err)
+	}
+	s.password = response.Password
+	return s.password, nil
+
+}
+
+// Hold the password in memory, and hand it to the agent if this process isn't the agent
+func (s *memoryStore) store(password string) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.isAgent {
+		if _, err := s.sendRequest(agentRequest{Command: agentCommandSet, Password: password}); err != nil {
+			return fmt.Errorf("Could not give password to the password agent: %w", err)
+		}
+	}
+	s.password = password
+	return nil
+
+}
+
+// Forget the password, and tell the agent to forget it too
+func (s *memoryStore) remove() error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.password = ""
+	if s.isAgent {
+		return nil
+	}
+
+	// If the agent isn't running, there's nothing for it to forget
+	if _, err := os.Stat(s.socketPath); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := s.sendRequest(agentRequest{Command: agentCommandClear}); err != nil {
+		return fmt.Errorf("Could not remove password from the password agent: %w", err)
+	}
+	return nil
+
+}
+
+// Start listening for password requests on the agent socket
+func (s *memoryStore) startAgent() error {
+
+	// Remove a stale socket left behind by a previous run
+	if _, err := os.Stat(s.socketPath); err == nil {
+		if conn, err := net.DialTimeout("unix", s.socketPath, agentRequestTimeout); err == nil {
+			conn.Close()
+			return fmt.Errorf("another password agent is already listening on %s", s.socketPath)
+		}
+		if err := os.Remove(s.socketPath); err != nil {
+			return fmt.Errorf("error removing stale password agent socket [%s]: %w", s.socketPath, err)
+		}
+	}
+
+	listener, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return fmt.Errorf("error starting password agent on %s: %w", s.socketPath, err)
+	}
+	if err := os.Chmod(s.socketPath, FileMode); err != nil {
+		listener.Close()
+		return fmt.Errorf("error setting password agent socket permissions: %w", err)
+	}
+
+	s.lock.Lock()
+	s.isAgent = true
+	s.lock.Unlock()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.handleConnection(conn)
+		}
+	}()
+	return nil
+
+}
+
+// Handle a single request to the agent
+func (s *memoryStore) handleConnection(conn net.Conn) {
+
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentRequestTimeout))
+
+	var request agentRequest
+	var response agentResponse
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		response.Error = fmt.Sprintf("invalid request: %s", err.Error())
+		json.NewEncoder(conn).Encode(response)
+		return
+	}
+
+	s.lock.Lock()
+	switch request.Command {
+	case agentCommandGet:
+		if s.password == "" {
+			response.Error = "the password has not been provided yet"
+		} else {
+			response.Password = s.password
+		}
+	case agentCommandSet:
+		if s.password != "" {
+			response.Error = "the password is already set"
+		} else if len(request.Password) < MinPasswordLength {
+			response.Error = fmt.Sprintf("the password must be at least %d characters long", MinPasswordLength)
+		} else {
+			s.password = request.Password
+		}
+	case agentCommandClear:
+		s.password = ""
+	default:
+		response.Error = fmt.Sprintf("unknown command [%s]", request.Command)
+	}
+	s.lock.Unlock()
+
+	json.NewEncoder(conn).Encode(response)
+
+}
+
+// Send a request to the agent and wait for its response
+func (s *memoryStore) sendRequest(request agentRequest) (agentResponse, error) {
+
+	conn, err := net.DialTimeout("unix", s.socketPath, agentRequestTimeout)
+	if err != nil {
+		return agentResponse{}, fmt.Errorf("the password agent at %s is not running; it is started by the node daemon: %w", s.socketPath, err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentRequestTimeout))
+
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return agentResponse{}, fmt.Errorf("error sending request to the password agent: %w", err)
+	}
+	var response agentResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return agentResponse{}, fmt.Errorf("error reading response from the password agent: %w", err)
+	}
+	if response.Error != "" {
+		return agentResponse{}, errors.New(response.Error)
+	}
+	return response, nil
+
+}
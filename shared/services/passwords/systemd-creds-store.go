@@ -0,0 +1,114 @@
+package passwords
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Config
+const (
+	systemdCredsCommand     string = "systemd-creds"
+	SystemdCredentialName   string = "rocketpool-wallet-password"
+	credentialsDirectoryEnv string = "CREDENTIALS_DIRECTORY"
+)
+
+// Stores the password in a credential encrypted by systemd-creds, which is sealed to the TPM2 chip when one is available
+type systemdCredsStore struct {
+	credentialPath string
+}
+
+// Create new systemd-creds store
+func newSystemdCredsStore(credentialPath string) *systemdCredsStore {
+	return &systemdCredsStore{
+		credentialPath: credentialPath,
+	}
+}
+
+// Check if the credential exists
+func (s *systemdCredsStore) isSet() bool {
+	if _, err := os.Stat(s.credentialPath); err == nil {
+		return true
+	}
+	_, exists := s.getServiceCredentialPath()
+	return exists
+}
+
+// Decrypt the password from the credential
+func (s *systemdCredsStore) load() (string, error) {
+
+	// If systemd already decrypted it for this service via LoadCredentialEncrypted=, use that copy
+	if path, exists := s.getServiceCredentialPath(); exists {
+		password, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Could not read password credential: %w", err)
+		}
+		return string(password), nil
+	}
+
+	password, err := runSystemdCreds(nil, "decrypt", "--name="+SystemdCredentialName, s.credentialPath, "-")
+	if err != nil {
+		return "", fmt.Errorf("Could not decrypt password credential: %w", err)
+	}
+	return string(password), nil
+
+}
+
+// Encrypt the password into the credential
+func (s *systemdCredsStore) store(password string) error {
+	if _, err := runSystemdCreds([]byte(password), "encrypt", "--name="+SystemdCredentialName, "-", s.credentialPath); err != nil {
+		return fmt.Errorf("Could not encrypt password credential: %w", err)
+	}
+	if err := os.Chmod(s.credentialPath, FileMode); err != nil {
+		return fmt.Errorf("Could not set password credential permissions: %w", err)
+	}
+	return nil
+}
+
+// Delete the credential
+func (s *systemdCredsStore) remove() error {
+	_, err := os.Stat(s.credentialPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error checking password credential path: %w", err)
+	}
+	return os.Remove(s.credentialPath)
+}
+
+// Get the path of the decrypted credential provided by systemd to the running service, if there is one
+func (s *systemdCredsStore) getServiceCredentialPath() (string, bool) {
+	credentialsDir := os.Getenv(credentialsDirectoryEnv)
+	if credentialsDir == "" {
+		return "", false
+	}
+	path := filepath.Join(credentialsDir, SystemdCredentialName)
+	if _, err := os.Stat(path); err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// Run systemd-creds and get its output
+func runSystemdCreds(input []byte, args ...string) ([]byte, error) {
+
+	cmd := exec.Command(systemdCredsCommand, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errMessage := strings.TrimSpace(stderr.String()); errMessage != "" {
+			return nil, fmt.Errorf("%w: %s", err, errMessage)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+
+}
@@ -0,0 +1,109 @@
+package privatetx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Seb369888/smartnode/shared/services/config"
+)
+
+// The method used to submit transactions privately
+const SendPrivateTransactionMethod string = "eth_sendPrivateTransaction"
+
+// The kinds of transactions that can use the private route
+type Category string
+
+const (
+	Category_WithdrawalAddress Category = "withdrawal-address"
+	Category_RplStake          Category = "rpl-stake"
+	Category_OdaoSubmission    Category = "odao-submission"
+)
+
+// The context key for a transaction's category
+type categoryKey struct{}
+
+// Mark the transactions sent with a context as belonging to a category
+func WithCategory(ctx context.Context, category Category) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, categoryKey{}, category)
+}
+
+// Get the category of the transactions sent with a context, if it has one
+func GetCategory(ctx context.Context) (Category, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	category, ok := ctx.Value(categoryKey{}).(Category)
+	return category, ok
+}
+
+// The parameters of a private transaction submission
+type PrivateTransaction struct {
+	Tx             hexutil.Bytes  `json:"tx"`
+	MaxBlockNumber hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+// Sends transactions in the enabled categories to a private transaction endpoint
+type Router struct {
+	url        string
+	timeout    time.Duration
+	categories map[Category]bool
+}
+
+// Create a router from the config, or nil if the private route is disabled
+func NewRouter(cfg *config.RocketPoolConfig) *Router {
+	url := strings.TrimSpace(cfg.Smartnode.PrivateTxEndpoint.Value.(string))
+	if url == "" {
+		return nil
+	}
+	return &Router{
+		url:     url,
+		timeout: time.Duration(cfg.Smartnode.PrivateTxTimeout.Value.(uint64)) * time.Second,
+		categories: map[Category]bool{
+			Category_WithdrawalAddress: cfg.Smartnode.PrivateTxWithdrawalAddress.Value.(bool),
+			Category_RplStake:          cfg.Smartnode.PrivateTxRplStakeThreshold.Value.(float64) > 0,
+			Category_OdaoSubmission:    cfg.Smartnode.PrivateTxOdaoSubmissions.Value.(bool),
+		},
+	}
+}
+
+// Check if a category uses the private route
+func (r *Router) IsEnabled(category Category) bool {
+	return r != nil && r.categories[category]
+}
+
+// Get how long to wait for a private transaction to be included before broadcasting it publicly
+func (r *Router) GetTimeout() time.Duration {
+	return r.timeout
+}
+
+// Submit a signed transaction to the private endpoint; it will be dropped if it isn't included by the max block number
+func (r *Router) Send(ctx context.Context, tx *types.Transaction, maxBlockNumber uint64) error {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("error encoding transaction: %w", err)
+	}
+	client, err := rpc.DialContext(ctx, r.url)
+	if err != nil {
+		return fmt.Errorf("error connecting to private transaction endpoint: %w", err)
+	}
+	defer client.Close()
+
+	var result interface{}
+	params := PrivateTransaction{
+		Tx:             rawTx,
+		MaxBlockNumber: hexutil.Uint64(maxBlockNumber),
+	}
+	if err := client.CallContext(ctx, &result, SendPrivateTransactionMethod, params); err != nil {
+		return fmt.Errorf("error submitting private transaction: %w", err)
+	}
+	return nil
+}
@@ -44,6 +44,11 @@ func RequireNodePassword(c *cli.Context) error {
 }
 
 func RequireNodeWallet(c *cli.Context) error {
+	if c.GlobalString("watch") != "" {
+		// A watched node doesn't need a local wallet, and GetWallet validates the address
+		_, err := GetWallet(c)
+		return err
+	}
 	if err := RequireNodePassword(c); err != nil {
 		return err
 	}
@@ -0,0 +1,24 @@
+package rocketpool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+// Get the analytics snapshots from the last number of days
+func (c *Client) AnalyticsSnapshots(days uint64, intervalMinutes uint64) (api.AnalyticsSnapshotsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("analytics snapshots %d %d", days, intervalMinutes))
+	if err != nil {
+		return api.AnalyticsSnapshotsResponse{}, fmt.Errorf("Could not get analytics snapshots: %w", err)
+	}
+	var response api.AnalyticsSnapshotsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.AnalyticsSnapshotsResponse{}, fmt.Errorf("Could not decode analytics snapshots response: %w", err)
+	}
+	if response.Error != "" {
+		return api.AnalyticsSnapshotsResponse{}, fmt.Errorf("Could not get analytics snapshots: %s", response.Error)
+	}
+	return response, nil
+}
@@ -2,6 +2,7 @@ package rocketpool
 
 import (
 	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -17,12 +18,15 @@ import (
 	"time"
 
 	"github.com/a8m/envsubst"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh"
 
 	"github.com/Seb369888/smartnode/addons/graffiti_wall_writer"
+	"github.com/Seb369888/smartnode/shared/services/backup"
 	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/simulation"
 	cfgtypes "github.com/Seb369888/smartnode/shared/types/config"
 	"github.com/Seb369888/smartnode/shared/utils/rp"
 	"github.com/alessio/shellescape"
@@ -53,8 +57,9 @@ const (
 	defaultFeeRecipientFile       string = "fr-default.tmpl"
 	defaultNativeFeeRecipientFile string = "fr-default-env.tmpl"
 
-	templateSuffix    string = ".tmpl"
-	composeFileSuffix string = ".yml"
+	templateSuffix     string = ".tmpl"
+	composeFileSuffix  string = ".yml"
+	backupVolumeSuffix string = "-backups"
 
 	nethermindPruneStarterCommand string = "dotnet /setup/NethermindPruneStarter/NethermindPruneStarter.dll"
 	nethermindAdminUrl            string = "http://127.0.0.1:7434"
@@ -94,17 +99,33 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	watchAddress       string
+	simulate           bool
+	simulations        []simulation.Result
 }
 
 // Create new poolsea Pool client from CLI context
 func NewClientFromCtx(c *cli.Context) (*Client, error) {
-	return NewClient(c.GlobalString("config-path"),
+	client, err := NewClient(c.GlobalString("config-path"),
 		c.GlobalString("daemon-path"),
 		c.GlobalFloat64("maxFee"),
 		c.GlobalFloat64("maxPrioFee"),
 		c.GlobalUint64("gasLimit"),
 		c.GlobalString("nonce"),
 		c.GlobalBool("debug"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the watch address here so the API doesn't have to report it
+	if watchAddress := c.GlobalString("watch"); watchAddress != "" {
+		if !common.IsHexAddress(watchAddress) {
+			return nil, fmt.Errorf("Invalid watch address '%s'", watchAddress)
+		}
+		client.watchAddress = common.HexToAddress(watchAddress).Hex()
+	}
+	client.simulate = c.GlobalBool("simulate")
+	return client, nil
 }
 
 // Create new poolsea Pool client
@@ -1580,6 +1601,15 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 		deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.MevBoostContainerName+composeFileSuffix))
 	}
 
+	// Mount the automatic backup folder into the node container
+	if cfg.Smartnode.AutoBackupInterval.Value.(uint64) > 0 {
+		backupComposePath, err := deployBackupVolume(cfg, runtimeFolder)
+		if err != nil {
+			return []string{}, err
+		}
+		deployedContainers = append(deployedContainers, backupComposePath)
+	}
+
 	// Create the custom keys dir
 	customKeyDir, err := homedir.Expand(filepath.Join(cfg.Smartnode.DataPath.Value.(string), "custom-keys"))
 	if err != nil {
@@ -1607,6 +1637,32 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 
 }
 
+// Create the host's automatic backup folder and write a compose file that mounts it into the node container
+func deployBackupVolume(cfg *config.RocketPoolConfig, runtimeFolder string) (string, error) {
+
+	backupFolder, err := homedir.Expand(os.ExpandEnv(cfg.Smartnode.GetAutoBackupFolder(false)))
+	if err != nil {
+		return "", fmt.Errorf("error expanding automatic backup folder: %w", err)
+	}
+	if !filepath.IsAbs(backupFolder) {
+		return "", fmt.Errorf("the automatic backup path [%s] must be an absolute path", backupFolder)
+	}
+	err = os.MkdirAll(backupFolder, backup.DefaultDirectoryMode)
+	if err != nil {
+		return "", fmt.Errorf("error creating automatic backup folder [%s]: %w", backupFolder, err)
+	}
+
+	// Compose merges this volume into the node service from its template
+	contents := fmt.Sprintf("services:\n  %s:\n    volumes:\n      - %s\n", config.NodeContainerName, strconv.Quote(backupFolder+":"+config.DaemonBackupPath))
+	composePath := filepath.Join(runtimeFolder, config.NodeContainerName+backupVolumeSuffix+composeFileSuffix)
+	err = os.WriteFile(composePath, []byte(contents), 0664)
+	if err != nil {
+		return "", fmt.Errorf("could not write automatic backup volume file to %s: %w", composePath, err)
+	}
+	return composePath, nil
+
+}
+
 // Handle composing for addons
 func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir string, settings map[string]string, deployedContainers []string) ([]string, error) {
 
@@ -1651,15 +1707,17 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getWatchFlag(), c.getSimulateFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getWatchFlag(),
+			c.getSimulateFlag(),
 			args)
 	}
 
@@ -1684,13 +1742,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getWatchFlag(), c.getSimulateFlag(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1698,6 +1756,8 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getWatchFlag(),
+			c.getSimulateFlag(),
 			args)
 	}
 
@@ -1756,6 +1816,14 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 	c.maxPrioFee = c.originalMaxPrioFee
 	c.gasLimit = c.originalGasLimit
 
+	// Keep the simulations of the transactions the call estimated until they're shown
+	var response struct {
+		Simulations []simulation.Result `json:"simulations"`
+	}
+	if err == nil && json.Unmarshal(output, &response) == nil && len(response.Simulations) > 0 {
+		c.simulations = response.Simulations
+	}
+
 	return output, err
 }
 
@@ -1789,6 +1857,39 @@ func (c *Client) getCustomNonce() string {
 	return nonce
 }
 
+// Get the address of the node being watched in read-only mode, or an empty string if there isn't one
+func (c *Client) GetWatchAddress() string {
+	return c.watchAddress
+}
+
+// Get the flag that runs API calls against a watched node, if one was provided
+func (c *Client) getWatchFlag() string {
+	if c.watchAddress == "" {
+		return ""
+	}
+	return fmt.Sprintf("--watch %s", c.watchAddress)
+}
+
+// Check if transactions are only being simulated instead of sent
+func (c *Client) IsSimulating() bool {
+	return c.simulate
+}
+
+// Get the simulations of the transactions estimated by the latest API call that estimated any, and clear them
+func (c *Client) TakeSimulations() []simulation.Result {
+	simulations := c.simulations
+	c.simulations = nil
+	return simulations
+}
+
+// Get the flag that makes the API simulate transactions instead of sending them, if it was requested
+func (c *Client) getSimulateFlag() string {
+	if !c.simulate {
+		return ""
+	}
+	return "--simulate"
+}
+
 // Get the first downloader available to the system
 func (c *Client) getDownloader() (string, error) {
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/Seb369888/poolsea-go/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/Seb369888/smartnode/shared/types/api"
@@ -56,6 +57,22 @@ func (c *Client) MinipoolStatus() (api.MinipoolStatusResponse, error) {
 	return response, nil
 }
 
+// Get the lifecycle transitions of the node's minipools
+func (c *Client) MinipoolEvents() (api.MinipoolEventsResponse, error) {
+	responseBytes, err := c.callAPI("minipool events")
+	if err != nil {
+		return api.MinipoolEventsResponse{}, fmt.Errorf("Could not get minipool events: %w", err)
+	}
+	var response api.MinipoolEventsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolEventsResponse{}, fmt.Errorf("Could not decode minipool events response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolEventsResponse{}, fmt.Errorf("Could not get minipool events: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool is eligible for a refund
 func (c *Client) CanRefundMinipool(address common.Address) (api.CanRefundMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-refund %s", address.Hex()))
@@ -216,6 +233,38 @@ func (c *Client) ExitMinipool(address common.Address) (api.ExitMinipoolResponse,
 	return response, nil
 }
 
+// Get a pre-signed voluntary exit message for a minipool's validator
+func (c *Client) GetSignedExitMessage(address common.Address, epoch uint64) (api.GetSignedExitMessageResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-signed-exit-message --epoch %d %s", epoch, address.Hex()))
+	if err != nil {
+		return api.GetSignedExitMessageResponse{}, fmt.Errorf("Could not get signed exit message: %w", err)
+	}
+	var response api.GetSignedExitMessageResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetSignedExitMessageResponse{}, fmt.Errorf("Could not decode signed exit message response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetSignedExitMessageResponse{}, fmt.Errorf("Could not get signed exit message: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Broadcast a pre-signed voluntary exit message
+func (c *Client) BroadcastExitMessage(validatorIndex uint64, epoch uint64, signature types.ValidatorSignature) (api.BroadcastExitMessageResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool broadcast-exit-message %d %d %s", validatorIndex, epoch, signature.Hex()))
+	if err != nil {
+		return api.BroadcastExitMessageResponse{}, fmt.Errorf("Could not broadcast exit message: %w", err)
+	}
+	var response api.BroadcastExitMessageResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastExitMessageResponse{}, fmt.Errorf("Could not decode broadcast exit message response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastExitMessageResponse{}, fmt.Errorf("Could not broadcast exit message: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check all of the node's minipools for closure eligibility, and return the details of the closeable ones
 func (c *Client) GetMinipoolCloseDetailsForNode() (api.GetMinipoolCloseDetailsForNodeResponse, error) {
 	responseBytes, err := c.callAPI("minipool get-minipool-close-details-for-node")
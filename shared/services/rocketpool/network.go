@@ -55,6 +55,22 @@ func (c *Client) RplPrice() (api.RplPriceResponse, error) {
 	return response, nil
 }
 
+// Get max fee suggestions from the fee history of the Execution client
+func (c *Client) GetGasSuggestions() (api.GasSuggestionsResponse, error) {
+	responseBytes, err := c.callAPI("network gas-suggestions")
+	if err != nil {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %w", err)
+	}
+	var response api.GasSuggestionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not decode gas suggestions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get network stats
 func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
 	responseBytes, err := c.callAPI("network stats")
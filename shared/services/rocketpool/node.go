@@ -1,11 +1,13 @@
 package rocketpool
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -114,6 +116,38 @@ func (c *Client) SetNodeWithdrawalAddress(withdrawalAddress common.Address, conf
 	return response, nil
 }
 
+// Build an unsigned transaction that sets the node's withdrawal address, to be signed offline
+func (c *Client) BuildSetNodeWithdrawalAddress(nodeAddress common.Address, withdrawalAddress common.Address, confirm bool) (api.BuildOfflineTransactionResponse, error) {
+	responseBytes, err := c.callAPI("node build-set-withdrawal-address", nodeAddress.Hex(), withdrawalAddress.Hex(), strconv.FormatBool(confirm))
+	if err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node withdrawal address change: %w", err)
+	}
+	var response api.BuildOfflineTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not decode build node withdrawal address change response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node withdrawal address change: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Submit a transaction that was signed offline
+func (c *Client) BroadcastTransaction(signedTx []byte) (api.BroadcastTransactionResponse, error) {
+	responseBytes, err := c.callAPI("node broadcast", hex.EncodeToString(signedTx))
+	if err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Checks if the node's withdrawal address can be confirmed
 func (c *Client) CanConfirmNodeWithdrawalAddress() (api.CanSetNodeWithdrawalAddressResponse, error) {
 	responseBytes, err := c.callAPI("node can-confirm-withdrawal-address")
@@ -354,6 +388,38 @@ func (c *Client) NodeStakeRpl(amountWei *big.Int) (api.NodeStakeRplStakeResponse
 	return response, nil
 }
 
+// Build an unsigned RPL approval transaction for staking, to be signed offline
+func (c *Client) BuildNodeStakeRplApprove(nodeAddress common.Address, amountWei *big.Int) (api.BuildOfflineTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node build-stake-rpl-approve %s %s", nodeAddress.Hex(), amountWei.String()))
+	if err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node RPL approval: %w", err)
+	}
+	var response api.BuildOfflineTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not decode build node RPL approval response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node RPL approval: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Build an unsigned RPL stake transaction, to be signed offline
+func (c *Client) BuildNodeStakeRpl(nodeAddress common.Address, amountWei *big.Int) (api.BuildOfflineTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node build-stake-rpl %s %s", nodeAddress.Hex(), amountWei.String()))
+	if err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node RPL stake: %w", err)
+	}
+	var response api.BuildOfflineTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not decode build node RPL stake response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build node RPL stake: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get a node's RPL allowance for the staking contract
 func (c *Client) GetNodeStakeRplAllowance() (api.NodeStakeRplAllowanceResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node stake-rpl-allowance"))
@@ -1009,3 +1075,19 @@ func (c *Client) GetEthBalance() (api.NodeEthBalanceResponse, error) {
 	}
 	return response, nil
 }
+
+// Reconstruct the node's income and costs between two times from on-chain events and beacon withdrawals
+func (c *Client) NodeExportLedger(from time.Time, to time.Time, includeWithdrawals bool) (api.NodeExportLedgerResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node export-ledger %d %d %t", from.Unix(), to.Unix(), includeWithdrawals))
+	if err != nil {
+		return api.NodeExportLedgerResponse{}, fmt.Errorf("Could not export node ledger: %w", err)
+	}
+	var response api.NodeExportLedgerResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeExportLedgerResponse{}, fmt.Errorf("Could not decode export ledger response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeExportLedgerResponse{}, fmt.Errorf("Could not export node ledger: %s", response.Error)
+	}
+	return response, nil
+}
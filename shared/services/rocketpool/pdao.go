@@ -0,0 +1,24 @@
+package rocketpool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+// Get the current protocol DAO settings
+func (c *Client) PDAOSettings() (api.PDAOSettingsResponse, error) {
+	responseBytes, err := c.callAPI("pdao get-settings")
+	if err != nil {
+		return api.PDAOSettingsResponse{}, fmt.Errorf("Could not get protocol DAO settings: %w", err)
+	}
+	var response api.PDAOSettingsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOSettingsResponse{}, fmt.Errorf("Could not decode protocol DAO settings response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOSettingsResponse{}, fmt.Errorf("Could not get protocol DAO settings: %s", response.Error)
+	}
+	return response, nil
+}
@@ -40,6 +40,22 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 	return response, nil
 }
 
+// Unlock the wallet by providing its password to the password storage
+func (c *Client) UnlockWallet(password string) (api.UnlockWalletResponse, error) {
+	responseBytes, err := c.callAPI("wallet unlock", password)
+	if err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not unlock wallet: %w", err)
+	}
+	var response api.UnlockWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not decode unlock wallet response: %w", err)
+	}
+	if response.Error != "" {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not unlock wallet: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Initialize wallet
 func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, error) {
 	responseBytes, err := c.callAPI("wallet init --derivation-path", derivationPath)
@@ -164,6 +180,38 @@ func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
 	return response, nil
 }
 
+// Audit the validator keys of the node's minipools
+func (c *Client) AuditValidatorKeys() (api.AuditValidatorKeysResponse, error) {
+	responseBytes, err := c.callAPI("wallet audit-keys")
+	if err != nil {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not audit validator keys: %w", err)
+	}
+	var response api.AuditValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not decode audit validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not audit validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Repair the validator key for a minipool
+func (c *Client) RepairValidatorKey(minipoolAddress common.Address) (api.RepairValidatorKeyResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("wallet repair-key %s", minipoolAddress.Hex()))
+	if err != nil {
+		return api.RepairValidatorKeyResponse{}, fmt.Errorf("Could not repair validator key: %w", err)
+	}
+	var response api.RepairValidatorKeyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RepairValidatorKeyResponse{}, fmt.Errorf("Could not decode repair validator key response: %w", err)
+	}
+	if response.Error != "" {
+		return api.RepairValidatorKeyResponse{}, fmt.Errorf("Could not repair validator key: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Estimate the gas required to set an ENS reverse record to a name
 func (c *Client) EstimateGasSetEnsName(name string) (api.SetEnsNameResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("wallet estimate-gas-set-ens-name %s", name))
@@ -5,17 +5,21 @@ import (
 	"math/big"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/Seb369888/poolsea-go/rocketpool"
 	"github.com/Seb369888/poolsea-go/utils/eth"
 	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/urfave/cli"
 
 	"github.com/Seb369888/smartnode/shared/services/beacon"
 	"github.com/Seb369888/smartnode/shared/services/config"
 	"github.com/Seb369888/smartnode/shared/services/contracts"
+	"github.com/Seb369888/smartnode/shared/services/notify"
 	"github.com/Seb369888/smartnode/shared/services/passwords"
 	"github.com/Seb369888/smartnode/shared/services/wallet"
 	lhkeystore "github.com/Seb369888/smartnode/shared/services/wallet/keystore/lighthouse"
@@ -23,6 +27,7 @@ import (
 	nmkeystore "github.com/Seb369888/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/Seb369888/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/Seb369888/smartnode/shared/services/wallet/keystore/teku"
+	cfgtypes "github.com/Seb369888/smartnode/shared/types/config"
 	"github.com/Seb369888/smartnode/shared/utils/rp"
 )
 
@@ -32,6 +37,9 @@ const (
 	EcContainerName         string = "eth1"
 	FallbackEcContainerName string = "eth1-fallback"
 	BnContainerName         string = "eth2"
+
+	minipoolDelegateContractName    string = "poolseaMinipoolDelegate"
+	distributorDelegateContractName string = "poolseaNodeDistributorDelegate"
 )
 
 // Service instances & initializers
@@ -47,6 +55,7 @@ var (
 	snapshotDelegation *contracts.SnapshotDelegation
 	beaconClient       beacon.Client
 	docker             *client.Client
+	notifier           *notify.Notifier
 
 	initCfg                sync.Once
 	initPasswordManager    sync.Once
@@ -59,6 +68,7 @@ var (
 	initSnapshotDelegation sync.Once
 	initBeaconClient       sync.Once
 	initDocker             sync.Once
+	initNotifier           sync.Once
 )
 
 //
@@ -165,6 +175,14 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return getDocker()
 }
 
+func GetNotifier(c *cli.Context) (*notify.Notifier, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getNotifier(c, cfg)
+}
+
 //
 // Service instance getters
 //
@@ -183,7 +201,16 @@ func getConfig(c *cli.Context) (*config.RocketPoolConfig, error) {
 
 func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager {
 	initPasswordManager.Do(func() {
-		passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		switch cfg.Smartnode.PasswordStorageMode.Value.(cfgtypes.PasswordStorageMode) {
+		case cfgtypes.PasswordStorageMode_Keyring:
+			passwordManager = passwords.NewKeyringPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		case cfgtypes.PasswordStorageMode_SystemdCreds:
+			passwordManager = passwords.NewSystemdCredsPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordCredentialPath()))
+		case cfgtypes.PasswordStorageMode_Memory:
+			passwordManager = passwords.NewMemoryPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordAgentSocketPath()))
+		default:
+			passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		}
 	})
 	return passwordManager
 }
@@ -211,6 +238,16 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 
 		chainId := cfg.Smartnode.GetChainID()
 
+		// Watch another node in read-only mode if requested, without decrypting the local wallet
+		if watchAddress := c.GlobalString("watch"); watchAddress != "" {
+			if !common.IsHexAddress(watchAddress) {
+				err = fmt.Errorf("Invalid watch address '%s'", watchAddress)
+				return
+			}
+			nodeWallet = wallet.NewWatchOnlyWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, pm, common.HexToAddress(watchAddress))
+			return
+		}
+
 		nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm)
 		if err != nil {
 			return
@@ -244,6 +281,31 @@ func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClien
 			if c.GlobalBool("force-fallbacks") {
 				ecManager.primaryReady = false
 			}
+			if c.GlobalBool("simulate") {
+				ecManager.simulateOnly = true
+			}
+
+			// Blocks are produced once per slot, so the private route gets the slot time from the Beacon node when it needs it
+			ecManager.getSlotTime = func() (time.Duration, error) {
+				bc, err := getBeaconClient(c, cfg)
+				if err != nil {
+					return 0, err
+				}
+				eth2Config, err := bc.GetEth2Config()
+				if err != nil {
+					return 0, err
+				}
+				return time.Duration(eth2Config.SecondsPerSlot) * time.Second, nil
+			}
+
+			// Simulations decode custom errors with the ABI of the contract being called
+			ecManager.getContractAbis = func(address common.Address) []*abi.ABI {
+				rocketPool, err := getRocketPool(cfg, ecManager)
+				if err != nil {
+					return nil
+				}
+				return getContractAbis(rocketPool, address)
+			}
 		}
 	})
 	return ecManager, err
@@ -302,6 +364,30 @@ func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClien
 	return bcManager, err
 }
 
+func getNotifier(c *cli.Context, cfg *config.RocketPoolConfig) (*notify.Notifier, error) {
+	var err error
+	initNotifier.Do(func() {
+		targets := []notify.Target{}
+		if url := cfg.Smartnode.NotifyChatWebhookUrl.Value.(string); url != "" {
+			targets = append(targets, notify.NewChatWebhookTarget(url))
+		}
+		if url := cfg.Smartnode.NotifyJsonWebhookUrl.Value.(string); url != "" {
+			targets = append(targets, notify.NewJsonWebhookTarget(url))
+		}
+		if url := cfg.Smartnode.NotifyNtfyUrl.Value.(string); url != "" {
+			targets = append(targets, notify.NewNtfyTarget(url, cfg.Smartnode.NotifyNtfyToken.Value.(string)))
+		}
+		notifier, err = notify.NewNotifier(notify.NotifierSettings{
+			Source:         c.Command.Name,
+			Targets:        targets,
+			RateLimit:      cfg.Smartnode.NotifyRateLimit.Value.(uint64),
+			ErrorThreshold: cfg.Smartnode.NotifyErrorThreshold.Value.(uint64),
+			TemplatesPath:  os.ExpandEnv(cfg.Smartnode.GetNotificationTemplatesPath()),
+		})
+	})
+	return notifier, err
+}
+
 func getDocker() (*client.Client, error) {
 	var err error
 	initDocker.Do(func() {
@@ -309,3 +395,21 @@ func getDocker() (*client.Client, error) {
 	})
 	return docker, err
 }
+
+// Get the ABIs of a Rocket Pool contract from its registered name.
+// Minipools and fee distributors aren't registered, so the ABIs of their delegates are used for any other address.
+func getContractAbis(rocketPool *rocketpool.RocketPool, address common.Address) []*abi.ABI {
+	contractNames := []string{minipoolDelegateContractName, distributorDelegateContractName}
+	name, err := rocketPool.RocketStorage.GetString(nil, crypto.Keccak256Hash([]byte("contract.name"), address.Bytes()))
+	if err == nil && name != "" {
+		contractNames = []string{name}
+	}
+	abis := []*abi.ABI{}
+	for _, contractName := range contractNames {
+		contractAbi, err := rocketPool.GetABI(contractName, nil)
+		if err == nil {
+			abis = append(abis, contractAbi)
+		}
+	}
+	return abis
+}
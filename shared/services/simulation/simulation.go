@@ -0,0 +1,388 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The tracer used to follow the value and token transfers of a simulated transaction
+const CallTracer string = "callTracer"
+
+// The block simulations run against; the call and the trace both use it so their results agree
+const SimulationBlock string = "pending"
+
+// Returned instead of sending a transaction when simulate-only mode is enabled
+var ErrSimulateOnly = errors.New("the transaction was simulated but not sent because simulate-only mode is enabled")
+
+// Function selectors used to decode reverts and token transfers
+var (
+	panicSelector        = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+	transferSelector     = []byte{0xa9, 0x05, 0x9c, 0xbb} // transfer(address,uint256)
+	transferFromSelector = []byte{0x23, 0xb8, 0x72, 0xdd} // transferFrom(address,address,uint256)
+	symbolSelector       = []byte{0x95, 0xd8, 0x9b, 0x41} // symbol()
+)
+
+// A client that can simulate transactions
+type SimulationClient interface {
+	PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	TraceCall(ctx context.Context, call ethereum.CallMsg, blockTag string, tracer string) (json.RawMessage, error)
+}
+
+// The change in a token balance of the sender; amounts are shown assuming 18 decimals
+type TokenDelta struct {
+	Token  common.Address `json:"token"`
+	Symbol string         `json:"symbol"`
+	Delta  *big.Int       `json:"delta"`
+}
+
+// The result of simulating a transaction
+type Result struct {
+	TxHash       common.Hash    `json:"txHash"`
+	Sender       common.Address `json:"sender"`
+	Success      bool           `json:"success"`
+	RevertReason string         `json:"revertReason"`
+
+	// The change in the sender's ETH balance, not including gas
+	EthDelta *big.Int `json:"ethDelta"`
+
+	// The most the sender can pay for gas; only known for signed transactions
+	MaxGasCost *big.Int `json:"maxGasCost"`
+
+	// The changes in the sender's token balances; only available if the client supports tracing
+	TokenDeltas    []TokenDelta `json:"tokenDeltas"`
+	TraceAvailable bool         `json:"traceAvailable"`
+}
+
+// A call frame produced by the call tracer
+type callFrame struct {
+	Type  string         `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+	Input hexutil.Bytes  `json:"input"`
+	Error string         `json:"error"`
+	Calls []callFrame    `json:"calls"`
+}
+
+// Convert a call to the argument format used by the JSON-RPC API
+func ToCallArg(call ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": call.From,
+		"to":   call.To,
+	}
+	if len(call.Data) > 0 {
+		arg["data"] = hexutil.Bytes(call.Data)
+	}
+	if call.Value != nil {
+		arg["value"] = (*hexutil.Big)(call.Value)
+	}
+	if call.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(call.Gas)
+	}
+	if call.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(call.GasPrice)
+	}
+	return arg
+}
+
+// Simulate a signed transaction against the pending block.
+// Custom errors are decoded with the provided contract ABIs; the standard Error(string) and Panic(uint256) reverts are always decoded.
+func Simulate(ctx context.Context, client SimulationClient, tx *types.Transaction, abis ...*abi.ABI) (*Result, error) {
+
+	// Get the sender
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction sender: %w", err)
+	}
+	call := ethereum.CallMsg{
+		From:  sender,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+
+	result, err := SimulateCall(ctx, client, call, abis...)
+	if err != nil {
+		return nil, err
+	}
+	result.TxHash = tx.Hash()
+	result.MaxGasCost = new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+	return result, nil
+
+}
+
+// Simulate a transaction that hasn't been signed yet, such as one whose gas is being estimated, against the pending block
+func SimulateCall(ctx context.Context, client SimulationClient, call ethereum.CallMsg, abis ...*abi.ABI) (*Result, error) {
+
+	value := call.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	result := &Result{
+		Sender:      call.From,
+		EthDelta:    new(big.Int).Neg(value),
+		TokenDeltas: []TokenDelta{},
+	}
+
+	// Run the call
+	_, err := client.PendingCallContract(ctx, call)
+	if err != nil {
+		reason, reverted := GetRevertReason(err, abis...)
+		if !reverted {
+			return nil, fmt.Errorf("error simulating transaction: %w", err)
+		}
+		result.RevertReason = reason
+		return result, nil
+	}
+	result.Success = true
+
+	// Trace it to get the balance changes; tracing is often disabled, so this is best-effort
+	trace, err := client.TraceCall(ctx, call, SimulationBlock, CallTracer)
+	if err != nil {
+		return result, nil
+	}
+	var frame callFrame
+	if err := json.Unmarshal(trace, &frame); err != nil {
+		return result, nil
+	}
+	result.TraceAvailable = true
+	result.EthDelta = big.NewInt(0)
+	tokenDeltas := map[common.Address]*big.Int{}
+	tokens := []common.Address{}
+	addDeltas(frame, call.From, result.EthDelta, tokenDeltas, &tokens)
+	for _, token := range tokens {
+		delta := tokenDeltas[token]
+		if delta.Sign() == 0 {
+			continue
+		}
+		result.TokenDeltas = append(result.TokenDeltas, TokenDelta{
+			Token:  token,
+			Symbol: getTokenSymbol(ctx, client, token),
+			Delta:  delta,
+		})
+	}
+	return result, nil
+
+}
+
+// Get the revert reason from a call error; returns false if the error isn't a revert
+func GetRevertReason(err error, abis ...*abi.ABI) (string, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if dataString, ok := dataErr.ErrorData().(string); ok {
+			data, decodeErr := hexutil.Decode(dataString)
+			if decodeErr == nil && len(data) > 0 {
+				return DecodeRevert(data, abis...), true
+			}
+		}
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return strings.TrimPrefix(strings.TrimPrefix(err.Error(), "execution reverted"), ": "), true
+	}
+	return "", false
+}
+
+// Decode the data returned by a reverted call
+func DecodeRevert(data []byte, abis ...*abi.ABI) string {
+	if len(data) < 4 {
+		return fmt.Sprintf("unknown revert data 0x%s", hex.EncodeToString(data))
+	}
+
+	// Standard errors
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if bytes.Equal(data[:4], panicSelector) && len(data) == 36 {
+		return fmt.Sprintf("panic code 0x%s", new(big.Int).SetBytes(data[4:]).Text(16))
+	}
+
+	// Custom errors
+	for _, contractAbi := range abis {
+		if contractAbi == nil {
+			continue
+		}
+		for _, abiError := range contractAbi.Errors {
+			if !bytes.Equal(data[:4], abiError.ID[:4]) {
+				continue
+			}
+			values, err := abiError.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			args := make([]string, len(values))
+			for i, value := range values {
+				args[i] = fmt.Sprint(value)
+			}
+			return fmt.Sprintf("%s(%s)", abiError.Name, strings.Join(args, ", "))
+		}
+	}
+
+	return fmt.Sprintf("unknown revert data 0x%s", hex.EncodeToString(data))
+}
+
+// Add the ETH and token transfers to and from the address in a call frame and its successful subcalls.
+// Token transfers are detected from transfer and transferFrom calls, so mints and burns aren't included.
+func addDeltas(frame callFrame, address common.Address, ethDelta *big.Int, tokenDeltas map[common.Address]*big.Int, tokens *[]common.Address) {
+	if frame.Error != "" {
+		return
+	}
+
+	// ETH
+	if frame.Value != nil && frame.Type != "DELEGATECALL" {
+		value := frame.Value.ToInt()
+		if frame.From == address {
+			ethDelta.Sub(ethDelta, value)
+		}
+		if frame.To == address {
+			ethDelta.Add(ethDelta, value)
+		}
+	}
+
+	// Tokens
+	if frame.Type == "CALL" && len(frame.Input) >= 4 {
+		var from, to common.Address
+		var amount *big.Int
+		input := frame.Input
+		if bytes.Equal(input[:4], transferSelector) && len(input) == 68 {
+			from = frame.From
+			to = common.BytesToAddress(input[4:36])
+			amount = new(big.Int).SetBytes(input[36:68])
+		} else if bytes.Equal(input[:4], transferFromSelector) && len(input) == 100 {
+			from = common.BytesToAddress(input[4:36])
+			to = common.BytesToAddress(input[36:68])
+			amount = new(big.Int).SetBytes(input[68:100])
+		}
+		if amount != nil && (from == address || to == address) {
+			delta, exists := tokenDeltas[frame.To]
+			if !exists {
+				delta = big.NewInt(0)
+				tokenDeltas[frame.To] = delta
+				*tokens = append(*tokens, frame.To)
+			}
+			if from == address {
+				delta.Sub(delta, amount)
+			}
+			if to == address {
+				delta.Add(delta, amount)
+			}
+		}
+	}
+
+	for _, subcall := range frame.Calls {
+		addDeltas(subcall, address, ethDelta, tokenDeltas, tokens)
+	}
+}
+
+// Get the symbol of a token, or an empty string if it doesn't have one
+func getTokenSymbol(ctx context.Context, client SimulationClient, token common.Address) string {
+	data, err := client.CallContract(ctx, ethereum.CallMsg{To: &token, Data: symbolSelector}, nil)
+	if err != nil {
+		return ""
+	}
+	stringType, _ := abi.NewType("string", "", nil)
+	values, err := (abi.Arguments{{Type: stringType}}).Unpack(data)
+	if err != nil || len(values) == 0 {
+		return ""
+	}
+	symbol, _ := values[0].(string)
+	return symbol
+}
+
+// Describe the result of the simulation
+func (r *Result) String() string {
+	var builder strings.Builder
+	name := "the transaction"
+	if r.TxHash != (common.Hash{}) {
+		name = "transaction " + r.TxHash.Hex()
+	}
+	if !r.Success {
+		reason := r.RevertReason
+		if reason == "" {
+			reason = "no reason given"
+		}
+		fmt.Fprintf(&builder, "Simulating %s failed: it would revert (%s).", name, reason)
+		return builder.String()
+	}
+
+	fmt.Fprintf(&builder, "Simulating %s succeeded.\n", name)
+	fmt.Fprintf(&builder, "Expected ETH change for %s: %s ETH", r.Sender.Hex(), formatDelta(r.EthDelta))
+	if r.MaxGasCost != nil {
+		fmt.Fprintf(&builder, ", plus up to %.6f ETH for gas", eth.WeiToEth(r.MaxGasCost))
+	} else {
+		builder.WriteString(", plus gas")
+	}
+	if !r.TraceAvailable {
+		builder.WriteString("\nThe Execution client doesn't support tracing, so this only includes the ETH sent with the transaction and token changes couldn't be determined.")
+		return builder.String()
+	}
+	for _, tokenDelta := range r.TokenDeltas {
+		name := tokenDelta.Token.Hex()
+		if tokenDelta.Symbol != "" {
+			name = fmt.Sprintf("%s (%s)", tokenDelta.Symbol, name)
+		}
+		fmt.Fprintf(&builder, "\nExpected %s change: %s", name, formatDelta(tokenDelta.Delta))
+	}
+	return builder.String()
+}
+
+// Format a balance change with its sign
+func formatDelta(delta *big.Int) string {
+	if delta.Sign() > 0 {
+		return fmt.Sprintf("+%.6f", eth.WeiToEth(delta))
+	}
+	return fmt.Sprintf("%.6f", eth.WeiToEth(delta))
+}
+
+// The simulations of the transactions estimated while handling an API command, so the CLI can show them before they're confirmed
+var estimates = struct {
+	enabled bool
+	results []*Result
+	lock    sync.Mutex
+}{}
+
+// Start keeping the simulations of estimated transactions; only the API does this, since the CLI prompts with its responses
+func RecordEstimates() {
+	estimates.lock.Lock()
+	defer estimates.lock.Unlock()
+	estimates.enabled = true
+}
+
+// Check if the simulations of estimated transactions are being kept
+func IsRecordingEstimates() bool {
+	estimates.lock.Lock()
+	defer estimates.lock.Unlock()
+	return estimates.enabled
+}
+
+// Keep the simulation of an estimated transaction
+func RecordEstimate(result *Result) {
+	estimates.lock.Lock()
+	defer estimates.lock.Unlock()
+	if estimates.enabled {
+		estimates.results = append(estimates.results, result)
+	}
+}
+
+// Get the simulations of the transactions estimated so far
+func GetEstimates() []*Result {
+	estimates.lock.Lock()
+	defer estimates.lock.Unlock()
+	return estimates.results
+}
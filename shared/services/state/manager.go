@@ -70,6 +70,15 @@ func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address, ca
 	return m.getStateForNode(nodeAddress, targetSlot, calculateTotalEffectiveStake)
 }
 
+// Get the state of the network for a set of nodes using the latest Execution layer block, along with the total effective RPL stake for the network
+func (m *NetworkStateManager) GetHeadStateForNodes(nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	targetSlot, err := m.GetHeadSlot()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+	}
+	return CreateNetworkStateForNodes(m.cfg, m.rp, m.ec, m.bc, m.log, targetSlot, m.BeaconConfig, nodeAddresses, calculateTotalEffectiveStake)
+}
+
 // Get the state of the network at the provided Beacon slot
 func (m *NetworkStateManager) GetStateForSlot(slotNumber uint64) (*NetworkState, error) {
 	return m.getState(slotNumber)
@@ -0,0 +1,54 @@
+package derivation
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/accounts"
+)
+
+// Node key derivation paths
+const (
+	DefaultNodeKeyPath       = "m/44'/60'/0'/0/%d"
+	LedgerLiveNodeKeyPath    = "m/44'/60'/%d/0/0"
+	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
+)
+
+// Get the derived key & derivation path for the node account at the index.
+// This has no cgo dependencies so the CLI can derive the node key when signing offline.
+func DeriveNodeKey(mk *hdkeychain.ExtendedKey, pathFormat string, index uint, chainID *big.Int) (*hdkeychain.ExtendedKey, string, error) {
+
+	// Get derivation path
+	if pathFormat == "" {
+		pathFormat = DefaultNodeKeyPath
+	}
+	derivationPath := fmt.Sprintf(pathFormat, index)
+
+	// Parse derivation path
+	path, err := accounts.ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("Invalid node key derivation path '%s': %w", derivationPath, err)
+	}
+
+	// Follow derivation path
+	key := mk
+	for i, n := range path {
+		// Use the legacy implementation for Goerli
+		// TODO: remove this if Prater ever goes away!
+		if chainID.Cmp(big.NewInt(5)) == 0 {
+			key, err = key.DeriveNonStandard(n)
+		} else {
+			key, err = key.Derive(n)
+		}
+		if err == hdkeychain.ErrInvalidChild {
+			return DeriveNodeKey(mk, pathFormat, index+1, chainID)
+		} else if err != nil {
+			return nil, "", fmt.Errorf("Invalid child key at depth %d: %w", i, err)
+		}
+	}
+
+	// Return
+	return key, derivationPath, nil
+
+}
@@ -90,6 +90,36 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 	ks.as.PrivateKeys = append(ks.as.PrivateKeys, key.Marshal())
 	ks.as.PublicKeys = append(ks.as.PublicKeys, key.PublicKey().Marshal())
 
+	// Save the account store
+	return ks.saveAccountStore()
+
+}
+
+// Delete a validator key
+func (ks *Keystore) DeleteValidatorKey(pubkey types.ValidatorPubkey) error {
+
+	// Initialize the account store
+	if err := ks.initialize(); err != nil {
+		return err
+	}
+
+	// Remove the validator key from the account store
+	for ki := 0; ki < len(ks.as.PublicKeys); ki++ {
+		if bytes.Equal(pubkey.Bytes(), ks.as.PublicKeys[ki]) {
+			ks.as.PrivateKeys = append(ks.as.PrivateKeys[:ki], ks.as.PrivateKeys[ki+1:]...)
+			ks.as.PublicKeys = append(ks.as.PublicKeys[:ki], ks.as.PublicKeys[ki+1:]...)
+			return ks.saveAccountStore()
+		}
+	}
+
+	// Return if the key wasn't in the account store
+	return nil
+
+}
+
+// Encrypt the account store and write it to disk
+func (ks *Keystore) saveAccountStore() error {
+
 	// Encode account store
 	asBytes, err := json.Marshal(ks.as)
 	if err != nil {
@@ -5,17 +5,24 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
-	"math/big"
 
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Seb369888/smartnode/shared/services/nonce"
+	"github.com/Seb369888/smartnode/shared/services/wallet/derivation"
 )
 
 // Get the node account
 func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 
+	// Use the watched address in read-only mode
+	if w.IsWatchOnly() {
+		return accounts.Account{Address: *w.watchAddress}, nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
@@ -48,6 +55,11 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 // Get a transactor for the node account
 func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
+	// Check the wallet can sign
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -59,12 +71,12 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 		return nil, err
 	}
 
-	// Create & return transactor
+	// Create & return transactor; its nonces are reserved through the nonce manager
 	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
 	transactor.GasFeeCap = w.maxFee
 	transactor.GasTipCap = w.maxPriorityFee
 	transactor.GasLimit = w.gasLimit
-	transactor.Context = context.Background()
+	transactor.Context = nonce.WithReservation(context.Background())
 	return transactor, err
 
 }
@@ -72,6 +84,11 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 // Get the node account private key bytes
 func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
+	// Check the wallet can sign
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -125,32 +142,8 @@ func (w *Wallet) getNodeDerivedKey(index uint) (*hdkeychain.ExtendedKey, string,
 	if w.ws.DerivationPath == "" {
 		w.ws.DerivationPath = DefaultNodeKeyPath
 	}
-	derivationPath := fmt.Sprintf(w.ws.DerivationPath, index)
-
-	// Parse derivation path
-	path, err := accounts.ParseDerivationPath(derivationPath)
-	if err != nil {
-		return nil, "", fmt.Errorf("Invalid node key derivation path '%s': %w", derivationPath, err)
-	}
 
 	// Follow derivation path
-	key := w.mk
-	for i, n := range path {
-		// Use the legacy implementation for Goerli
-		// TODO: remove this if Prater ever goes away!
-		if w.chainID.Cmp(big.NewInt(5)) == 0 {
-			key, err = key.DeriveNonStandard(n)
-		} else {
-			key, err = key.Derive(n)
-		}
-		if err == hdkeychain.ErrInvalidChild {
-			return w.getNodeDerivedKey(index + 1)
-		} else if err != nil {
-			return nil, "", fmt.Errorf("Invalid child key at depth %d: %w", i, err)
-		}
-	}
-
-	// Return
-	return key, derivationPath, nil
+	return derivation.DeriveNodeKey(w.mk, w.ws.DerivationPath, index, w.chainID)
 
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/Seb369888/poolsea-go/types"
@@ -136,6 +137,36 @@ func (w *Wallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSP
 
 }
 
+// Gets the names of the wallet's keystores that contain a validator key
+func (w *Wallet) GetValidatorKeyKeystores(pubkey types.ValidatorPubkey) ([]string, error) {
+
+	names := []string{}
+	for name := range w.keystores {
+		key, err := w.keystores[name].LoadValidatorKey(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("error checking %s keystore for validator %s: %w", name, pubkey.Hex(), err)
+		}
+		if key != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+
+}
+
+// Deletes a validator key from all of the wallet's keystores
+func (w *Wallet) DeleteValidatorKey(pubkey types.ValidatorPubkey) error {
+
+	for name := range w.keystores {
+		if err := w.keystores[name].DeleteValidatorKey(pubkey); err != nil {
+			return fmt.Errorf("Could not delete %s validator key: %w", name, err)
+		}
+	}
+	return nil
+
+}
+
 // Deletes all of the keystore directories and persistent VC storage
 func (w *Wallet) DeleteValidatorStores() error {
 
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
@@ -19,16 +20,20 @@ import (
 	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 
 	"github.com/Seb369888/smartnode/shared/services/passwords"
+	"github.com/Seb369888/smartnode/shared/services/wallet/derivation"
 	"github.com/Seb369888/smartnode/shared/services/wallet/keystore"
 )
 
+// Returned when trying to sign with a wallet in read-only watch mode
+var ErrWatchOnly = errors.New("The node is being watched in read-only mode; transactions and signatures are not available. Run the command without --watch to use the node wallet.")
+
 // Config
 const (
 	EntropyBits              = 256
 	FileMode                 = 0600
-	DefaultNodeKeyPath       = "m/44'/60'/0'/0/%d"
-	LedgerLiveNodeKeyPath    = "m/44'/60'/%d/0/0"
-	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
+	DefaultNodeKeyPath       = derivation.DefaultNodeKeyPath
+	LedgerLiveNodeKeyPath    = derivation.LedgerLiveNodeKeyPath
+	MyEtherWalletNodeKeyPath = derivation.MyEtherWalletNodeKeyPath
 )
 
 // Wallet
@@ -61,6 +66,9 @@ type Wallet struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+
+	// The node address to report instead of the wallet's own in read-only watch mode
+	watchAddress *common.Address
 }
 
 // Encrypted wallet store
@@ -100,6 +108,20 @@ func NewWallet(walletPath string, chainId uint, maxFee *big.Int, maxPriorityFee
 
 }
 
+// Create a read-only wallet that reports the provided node address and refuses to sign anything.
+// The wallet store is never loaded, so the node password isn't needed.
+func NewWatchOnlyWallet(walletPath string, chainId uint, passwordManager *passwords.PasswordManager, address common.Address) *Wallet {
+	return &Wallet{
+		walletPath:    walletPath,
+		pm:            passwordManager,
+		encryptor:     eth2ks.New(),
+		chainID:       big.NewInt(int64(chainId)),
+		validatorKeys: map[uint]*eth2types.BLSPrivateKey{},
+		keystores:     map[string]keystore.Keystore{},
+		watchAddress:  &address,
+	}
+}
+
 // Gets the wallet's chain ID
 func (w *Wallet) GetChainID() *big.Int {
 	copy := big.NewInt(0).Set(w.chainID)
@@ -111,6 +133,11 @@ func (w *Wallet) AddKeystore(name string, ks keystore.Keystore) {
 	w.keystores[name] = ks
 }
 
+// Check if the wallet is in read-only watch mode
+func (w *Wallet) IsWatchOnly() bool {
+	return w.watchAddress != nil
+}
+
 // Check if the wallet has been initialized
 func (w *Wallet) IsInitialized() bool {
 	return (w.ws != nil && w.seed != nil && w.mk != nil)
@@ -272,6 +299,11 @@ func (w *Wallet) Delete() error {
 
 // Signs a serialized TX using the wallet's private key
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
+	// Check the wallet can sign
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -300,6 +332,11 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 
 // Signs an arbitrary message using the wallet's private key
 func (w *Wallet) SignMessage(message string) ([]byte, error) {
+	// Check the wallet can sign
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
 	// Get the wallet's private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
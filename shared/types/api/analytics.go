@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/Seb369888/smartnode/shared/services/analytics"
+)
+
+type AnalyticsSnapshotsResponse struct {
+	Status    string                          `json:"status"`
+	Error     string                          `json:"error"`
+	Snapshots []analytics.Snapshot            `json:"snapshots"`
+	Minipools []analytics.MinipoolPerformance `json:"minipools"`
+	Rewards   []analytics.RewardsRecord       `json:"rewards"`
+}
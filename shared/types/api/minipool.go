@@ -11,14 +11,20 @@ import (
 	"github.com/Seb369888/poolsea-go/tokens"
 	"github.com/Seb369888/poolsea-go/types"
 	"github.com/Seb369888/smartnode/shared/services/beacon"
+	"github.com/Seb369888/smartnode/shared/services/lifecycle"
+	"github.com/Seb369888/smartnode/shared/utils/rp"
 )
 
 type MinipoolStatusResponse struct {
-	Status          string            `json:"status"`
-	Error           string            `json:"error"`
-	Minipools       []MinipoolDetails `json:"minipools"`
-	LatestDelegate  common.Address    `json:"latestDelegate"`
-	IsAtlasDeployed bool              `json:"isAtlasDeployed"`
+	Status                string            `json:"status"`
+	Error                 string            `json:"error"`
+	Minipools             []MinipoolDetails `json:"minipools"`
+	LatestDelegate        common.Address    `json:"latestDelegate"`
+	IsAtlasDeployed       bool              `json:"isAtlasDeployed"`
+	DepositPoolInflowRate *big.Int          `json:"depositPoolInflowRate"`
+	QueueEtaLookback      time.Duration     `json:"queueEtaLookback"`
+	QueueEstimateError    string            `json:"queueEstimateError"`
+	LifecycleStageError   string            `json:"lifecycleStageError"`
 }
 type MinipoolDetails struct {
 	Address               common.Address         `json:"address"`
@@ -33,6 +39,7 @@ type MinipoolDetails struct {
 	CanStake              bool                   `json:"canStake"`
 	CanPromote            bool                   `json:"canPromote"`
 	Queue                 minipool.QueueDetails  `json:"queue"`
+	QueueEstimate         rp.QueueEstimate       `json:"queueEstimate"`
 	RefundAvailable       bool                   `json:"refundAvailable"`
 	WithdrawalAvailable   bool                   `json:"withdrawalAvailable"`
 	CloseAvailable        bool                   `json:"closeAvailable"`
@@ -45,6 +52,13 @@ type MinipoolDetails struct {
 	Penalties             uint64                 `json:"penalties"`
 	ReduceBondTime        time.Time              `json:"reduceBondTime"`
 	ReduceBondCancelled   bool                   `json:"reduceBondCancelled"`
+	Stage                 lifecycle.Stage        `json:"stage"`
+	StageWaitUntil        time.Time              `json:"stageWaitUntil"`
+}
+type MinipoolEventsResponse struct {
+	Status      string                 `json:"status"`
+	Error       string                 `json:"error"`
+	Transitions []lifecycle.Transition `json:"transitions"`
 }
 type ValidatorDetails struct {
 	Exists      bool     `json:"exists"`
@@ -101,6 +115,18 @@ type ExitMinipoolResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+type GetSignedExitMessageResponse struct {
+	Status          string                   `json:"status"`
+	Error           string                   `json:"error"`
+	ValidatorPubkey types.ValidatorPubkey    `json:"validatorPubkey"`
+	ValidatorIndex  uint64                   `json:"validatorIndex"`
+	Epoch           uint64                   `json:"epoch"`
+	Signature       types.ValidatorSignature `json:"signature"`
+}
+type BroadcastExitMessageResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
 
 type CanChangeWithdrawalCredentialsResponse struct {
 	Status    string `json:"status"`
@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seb369888/smartnode/shared/services/gas/feehistory"
 )
 
 type NodeFeeResponse struct {
@@ -95,3 +97,9 @@ type GetLatestDelegateResponse struct {
 	Error   string         `json:"error"`
 	Address common.Address `json:"address"`
 }
+
+type GasSuggestionsResponse struct {
+	Status     string                      `json:"status"`
+	Error      string                      `json:"error"`
+	Suggestion feehistory.GasFeeSuggestion `json:"suggestion"`
+}
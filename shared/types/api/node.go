@@ -532,3 +532,26 @@ type NodeEthBalanceResponse struct {
 	Error   string   `json:"error"`
 	Balance *big.Int `json:"balance"`
 }
+
+type NodeExportLedgerResponse struct {
+	Status      string            `json:"status"`
+	Error       string            `json:"error"`
+	FromBlock   uint64            `json:"fromBlock"`
+	ToBlock     uint64            `json:"toBlock"`
+	Withdrawals bool              `json:"withdrawals"`
+	Entries     []NodeLedgerEntry `json:"entries"`
+}
+
+// A single income or cost item of the node. Amounts are in wei; costs are negative.
+// Beacon withdrawals only set WithdrawnAmount, since they aren't income until the minipool distributes them.
+type NodeLedgerEntry struct {
+	Time            time.Time      `json:"time"`
+	Block           uint64         `json:"block"`
+	Type            string         `json:"type"`
+	Source          common.Address `json:"source"`
+	TxHash          common.Hash    `json:"txHash"`
+	EthAmount       *big.Int       `json:"ethAmount"`
+	RplAmount       *big.Int       `json:"rplAmount"`
+	RplPrice        *big.Int       `json:"rplPrice"`
+	WithdrawnAmount *big.Int       `json:"withdrawnAmount"`
+}
@@ -10,23 +10,25 @@ import (
 )
 
 type TNDAOStatusResponse struct {
-	Status         string `json:"status"`
-	Error          string `json:"error"`
-	IsMember       bool   `json:"isMember"`
-	CanJoin        bool   `json:"canJoin"`
-	CanLeave       bool   `json:"canLeave"`
-	CanReplace     bool   `json:"canReplace"`
-	TotalMembers   uint64 `json:"totalMembers"`
-	ProposalCounts struct {
-		Total     int `json:"total"`
-		Pending   int `json:"pending"`
-		Active    int `json:"active"`
-		Cancelled int `json:"cancelled"`
-		Defeated  int `json:"defeated"`
-		Succeeded int `json:"succeeded"`
-		Expired   int `json:"expired"`
-		Executed  int `json:"executed"`
-	} `json:"proposalCounts"`
+	Status         string            `json:"status"`
+	Error          string            `json:"error"`
+	IsMember       bool              `json:"isMember"`
+	CanJoin        bool              `json:"canJoin"`
+	CanLeave       bool              `json:"canLeave"`
+	CanReplace     bool              `json:"canReplace"`
+	TotalMembers   uint64            `json:"totalMembers"`
+	ProposalCounts DAOProposalCounts `json:"proposalCounts"`
+}
+
+type DAOProposalCounts struct {
+	Total     int `json:"total"`
+	Pending   int `json:"pending"`
+	Active    int `json:"active"`
+	Cancelled int `json:"cancelled"`
+	Defeated  int `json:"defeated"`
+	Succeeded int `json:"succeeded"`
+	Expired   int `json:"expired"`
+	Executed  int `json:"executed"`
 }
 
 type TNDAOMembersResponse struct {
@@ -125,6 +127,7 @@ type CancelTNDAOProposalResponse struct {
 type CanVoteOnTNDAOProposalResponse struct {
 	Status             string             `json:"status"`
 	Error              string             `json:"error"`
+	ProposalId         uint64             `json:"proposalId"`
 	CanVote            bool               `json:"canVote"`
 	DoesNotExist       bool               `json:"doesNotExist"`
 	InvalidState       bool               `json:"invalidState"`
@@ -138,6 +141,29 @@ type VoteOnTNDAOProposalResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type TNDAOProposalVote struct {
+	ProposalId uint64 `json:"proposalId" yaml:"proposal"`
+	Support    bool   `json:"support" yaml:"support"`
+}
+type CanVoteOnTNDAOProposalsResponse struct {
+	Status  string                           `json:"status"`
+	Error   string                           `json:"error"`
+	Votes   []CanVoteOnTNDAOProposalResponse `json:"votes"`
+	GasInfo rocketpool.GasInfo               `json:"gasInfo"`
+}
+type TNDAOProposalVoteResult struct {
+	ProposalId uint64      `json:"proposalId"`
+	Support    bool        `json:"support"`
+	Nonce      uint64      `json:"nonce"`
+	TxHash     common.Hash `json:"txHash"`
+	Error      string      `json:"error"`
+}
+type VoteOnTNDAOProposalsResponse struct {
+	Status string                    `json:"status"`
+	Error  string                    `json:"error"`
+	Votes  []TNDAOProposalVoteResult `json:"votes"`
+}
+
 type CanExecuteTNDAOProposalResponse struct {
 	Status       string             `json:"status"`
 	Error        string             `json:"error"`
@@ -207,79 +233,7 @@ type CanProposeTNDAOSettingResponse struct {
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
 }
-type ProposeTNDAOSettingMembersQuorumResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingMembersRplBondResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingMinipoolUnbondedMaxResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingProposalCooldownResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingProposalVoteTimespanResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingProposalVoteDelayTimespanResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingProposalExecuteTimespanResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingProposalActionTimespanResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingScrubPeriodResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingPromotionScrubPeriodResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingScrubPenaltyEnabledResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingBondReductionWindowStartResponse struct {
-	Status     string      `json:"status"`
-	Error      string      `json:"error"`
-	ProposalId uint64      `json:"proposalId"`
-	TxHash     common.Hash `json:"txHash"`
-}
-type ProposeTNDAOSettingBondReductionWindowLengthResponse struct {
+type ProposeTNDAOSettingResponse struct {
 	Status     string      `json:"status"`
 	Error      string      `json:"error"`
 	ProposalId uint64      `json:"proposalId"`
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// An unsigned node transaction, exported so it can be signed on an offline machine.
+// The payload is the serialized unsigned transaction and is what gets signed; the other fields are for review.
+type OfflineTransaction struct {
+	Description string         `json:"description"`
+	ChainID     *hexutil.Big   `json:"chainId"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+	Gas         hexutil.Uint64 `json:"gas"`
+	MaxFee      *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPrioFee  *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	Value       *hexutil.Big   `json:"value"`
+	Data        hexutil.Bytes  `json:"data"`
+	Payload     hexutil.Bytes  `json:"payload"`
+}
+
+// A node transaction that was signed on an offline machine and is ready to be broadcast
+type SignedOfflineTransaction struct {
+	Description string         `json:"description"`
+	From        common.Address `json:"from"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+	TxHash      common.Hash    `json:"txHash"`
+	Payload     hexutil.Bytes  `json:"payload"`
+}
+
+type BuildOfflineTransactionResponse struct {
+	Status      string             `json:"status"`
+	Error       string             `json:"error"`
+	Transaction OfflineTransaction `json:"transaction"`
+}
+
+type BuildOfflineTransactionsResponse struct {
+	Status       string               `json:"status"`
+	Error        string               `json:"error"`
+	Transactions []OfflineTransaction `json:"transactions"`
+}
+
+type BroadcastTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -0,0 +1,16 @@
+package api
+
+import (
+	"math/big"
+)
+
+type PDAOSettingValue struct {
+	Name  string   `json:"name"`
+	Path  string   `json:"path"`
+	Value *big.Int `json:"value"`
+}
+type PDAOSettingsResponse struct {
+	Status   string             `json:"status"`
+	Error    string             `json:"error"`
+	Settings []PDAOSettingValue `json:"settings"`
+}
@@ -22,6 +22,7 @@ type WalletStatusResponse struct {
 	Error             string         `json:"error"`
 	PasswordSet       bool           `json:"passwordSet"`
 	WalletInitialized bool           `json:"walletInitialized"`
+	WatchOnly         bool           `json:"watchOnly"`
 	AccountAddress    common.Address `json:"accountAddress"`
 }
 
@@ -30,6 +31,11 @@ type SetPasswordResponse struct {
 	Error  string `json:"error"`
 }
 
+type UnlockWalletResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
 type InitWalletResponse struct {
 	Status         string         `json:"status"`
 	Error          string         `json:"error"`
@@ -88,3 +94,40 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// A problem with a minipool's validator key found during a key audit
+type ValidatorKeyIssue string
+
+const (
+	ValidatorKeyIssue_None                 ValidatorKeyIssue = ""
+	ValidatorKeyIssue_MissingLocally       ValidatorKeyIssue = "missingLocally"
+	ValidatorKeyIssue_NotInValidatorClient ValidatorKeyIssue = "notInValidatorClient"
+	ValidatorKeyIssue_LoadedButExited      ValidatorKeyIssue = "loadedButExited"
+)
+
+type ValidatorKeyAudit struct {
+	MinipoolAddress   common.Address        `json:"minipoolAddress"`
+	Pubkey            types.ValidatorPubkey `json:"pubkey"`
+	IsDerived         bool                  `json:"isDerived"`
+	WalletIndex       uint                  `json:"walletIndex"`
+	DerivationPath    string                `json:"derivationPath"`
+	IsCustomKey       bool                  `json:"isCustomKey"`
+	Exited            bool                  `json:"exited"`
+	Keystores         []string              `json:"keystores"`
+	InValidatorClient bool                  `json:"inValidatorClient"`
+	Issue             ValidatorKeyIssue     `json:"issue"`
+}
+
+type AuditValidatorKeysResponse struct {
+	Status                string              `json:"status"`
+	Error                 string              `json:"error"`
+	ValidatorClient       string              `json:"validatorClient"`
+	Keys                  []ValidatorKeyAudit `json:"keys"`
+	SkippedCustomKeyFiles []string            `json:"skippedCustomKeyFiles"`
+}
+
+type RepairValidatorKeyResponse struct {
+	Status string            `json:"status"`
+	Error  string            `json:"error"`
+	Issue  ValidatorKeyIssue `json:"issue"`
+}
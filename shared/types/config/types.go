@@ -10,6 +10,8 @@ type RewardsMode string
 type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
+type PasswordStorageMode string
+type AutoRestakeMode string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -85,6 +87,24 @@ const (
 	RewardsMode_Generate RewardsMode = "generate"
 )
 
+// Enum to describe what the node daemon does with unclaimed rewards
+const (
+	AutoRestakeMode_Unknown  AutoRestakeMode = ""
+	AutoRestakeMode_Disabled AutoRestakeMode = "disabled"
+	AutoRestakeMode_Notify   AutoRestakeMode = "notify"
+	AutoRestakeMode_DryRun   AutoRestakeMode = "dryRun"
+	AutoRestakeMode_Enabled  AutoRestakeMode = "enabled"
+)
+
+// Enum to describe where the node wallet password is stored
+const (
+	PasswordStorageMode_Unknown      PasswordStorageMode = ""
+	PasswordStorageMode_File         PasswordStorageMode = "file"
+	PasswordStorageMode_Keyring      PasswordStorageMode = "keyring"
+	PasswordStorageMode_SystemdCreds PasswordStorageMode = "systemdCreds"
+	PasswordStorageMode_Memory       PasswordStorageMode = "memory"
+)
+
 // Enum to identify MEV-boost relays
 const (
 	MevRelayID_Unknown            MevRelayID = ""
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"reflect"
 
+	"github.com/Seb369888/smartnode/shared/services/simulation"
 	"github.com/Seb369888/smartnode/shared/types/api"
 )
 
@@ -60,6 +61,15 @@ func PrintResponse(response interface{}, responseError error) {
 		return
 	}
 
+	// Add the simulations of the transactions that were estimated
+	if simulations := simulation.GetEstimates(); len(simulations) > 0 && responseError == nil {
+		responseBytes, err = addSimulations(responseBytes, simulations)
+		if err != nil {
+			PrintErrorResponse(fmt.Errorf("Could not encode API response simulations: %w", err))
+			return
+		}
+	}
+
 	// Print
 	fmt.Println(string(responseBytes))
 
@@ -69,3 +79,17 @@ func PrintResponse(response interface{}, responseError error) {
 func PrintErrorResponse(err error) {
 	PrintResponse(&api.APIResponse{}, err)
 }
+
+// Add the simulations of estimated transactions to an encoded response
+func addSimulations(responseBytes []byte, simulations []*simulation.Result) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(responseBytes, &fields); err != nil {
+		return nil, err
+	}
+	simulationBytes, err := json.Marshal(simulations)
+	if err != nil {
+		return nil, err
+	}
+	fields["simulations"] = simulationBytes
+	return json.Marshal(fields)
+}
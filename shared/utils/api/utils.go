@@ -13,6 +13,7 @@ import (
 	"github.com/Seb369888/smartnode/shared/utils/log"
 	"github.com/Seb369888/smartnode/shared/utils/math"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // The fraction of the timeout period to trigger overdue transactions
@@ -34,6 +35,13 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 		logger.Println("This transaction does not check the gas threshold limit, continuing...")
 	}
 
+	PrintGasInfo(gasInfo, logger, maxFeeWei, gasLimit)
+	return true
+}
+
+// Print the total cost of a TX
+func PrintGasInfo(gasInfo rocketpool.GasInfo, logger log.ColorLogger, maxFeeWei *big.Int, gasLimit uint64) {
+
 	// Print the total TX cost
 	var gas *big.Int
 	var safeGas *big.Int
@@ -51,11 +59,16 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 		math.RoundDown(eth.WeiToEth(totalGasWei), 6),
 		math.RoundDown(eth.WeiToEth(totalSafeGasWei), 6))
 
-	return true
 }
 
 // Print a TX's details to the logger and waits for it to validated.
 func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger log.ColorLogger) error {
+	_, err := PrintAndWaitForTransactionReceipt(cfg, hash, ec, logger)
+	return err
+}
+
+// Print a TX's details to the logger, wait for it to be validated and return its receipt
+func PrintAndWaitForTransactionReceipt(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger log.ColorLogger) (*types.Receipt, error) {
 
 	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
 	hashString := hash.String()
@@ -68,26 +81,39 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 	logger.Println("Waiting for the transaction to be validated...")
 
 	// Wait for the TX to be included in a block
-	if _, err := utils.WaitForTransaction(ec, hash); err != nil {
-		return fmt.Errorf("Error waiting for transaction: %w", err)
+	receipt, err := utils.WaitForTransaction(ec, hash)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for transaction: %w", err)
 	}
 
-	return nil
+	return receipt, nil
 
 }
 
 // True if a transaction is due and needs to bypass the gas threshold
 func IsTransactionDue(rp *rocketpool.RocketPool, startTime time.Time) (bool, time.Duration, error) {
 
-	// Get the dissolve timeout
-	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, nil)
+	// Get the due time
+	dueTime, err := GetTransactionDueTime(rp, startTime)
 	if err != nil {
 		return false, 0, err
 	}
 
-	dueTime := timeout / time.Duration(TimeoutSafetyFactor)
-	isDue := time.Since(startTime) > dueTime
-	timeUntilDue := time.Until(startTime.Add(dueTime))
+	isDue := time.Now().After(dueTime)
+	timeUntilDue := time.Until(dueTime)
 	return isDue, timeUntilDue, nil
 
 }
+
+// Get the time a transaction that became eligible at the start time is due, leaving a safety margin before the launch timeout
+func GetTransactionDueTime(rp *rocketpool.RocketPool, startTime time.Time) (time.Time, error) {
+
+	// Get the dissolve timeout
+	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return startTime.Add(timeout / time.Duration(TimeoutSafetyFactor)), nil
+
+}
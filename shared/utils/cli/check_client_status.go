@@ -10,6 +10,16 @@ import (
 // Check the status of the Execution and Consensus client(s) and provision the API with them
 func CheckClientStatus(rp *rocketpool.Client) error {
 
+	// Remind the user that queries are running against another node
+	if watchAddress := rp.GetWatchAddress(); watchAddress != "" {
+		fmt.Printf("%sNOTE: watching node %s in read-only mode.%s\n\n", colorYellow, watchAddress, colorReset)
+	}
+
+	// Remind the user that transactions won't be sent
+	if rp.IsSimulating() {
+		fmt.Printf("%sNOTE: transactions will only be simulated and won't be sent.%s\n\n", colorYellow, colorReset)
+	}
+
 	// Check if the primary clients are up, synced, and able to respond to requests - if not, forces the use of the fallbacks for this command
 	response, err := rp.GetClientStatus()
 	if err != nil {
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+// Save an unsigned transaction so it can be signed on an offline machine
+func SaveOfflineTransaction(path string, tx api.OfflineTransaction) error {
+	if err := writeOfflineTransaction(path, tx); err != nil {
+		return err
+	}
+	printOfflineSigningSteps()
+	return nil
+}
+
+// Save several unsigned transactions, one per file, so they can be signed on an offline machine
+func SaveOfflineTransactions(paths []string, txs []api.OfflineTransaction) error {
+	for i, tx := range txs {
+		if err := writeOfflineTransaction(paths[i], tx); err != nil {
+			return err
+		}
+	}
+	printOfflineSigningSteps()
+	return nil
+}
+
+// Write an unsigned transaction to a file and print its details
+func writeOfflineTransaction(path string, tx api.OfflineTransaction) error {
+
+	// Write the file
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding transaction file path: %w", err)
+	}
+	bytes, err := json.MarshalIndent(tx, "", "    ")
+	if err != nil {
+		return fmt.Errorf("error serializing unsigned transaction: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0600); err != nil {
+		return fmt.Errorf("error saving unsigned transaction: %w", err)
+	}
+
+	// Log
+	fmt.Printf("The unsigned transaction (%s) was saved to %s.\n", tx.Description, path)
+	fmt.Printf("It will be sent from %s with nonce %d; any other transaction sent from the node with this nonce before it is broadcast will invalidate it.\n\n", tx.From.Hex(), uint64(tx.Nonce))
+	fmt.Println("The unsigned transaction payload is:")
+	fmt.Println(hexutil.Encode(tx.Payload))
+	fmt.Println()
+	return nil
+
+}
+
+// Print how to sign and submit exported transactions
+func printOfflineSigningSteps() {
+	fmt.Println("To complete it:")
+	fmt.Println("1. Copy the file (or the payload above) to your offline machine.")
+	fmt.Println("2. Sign it there with `rocketpool wallet sign-offline --file <file>`.")
+	fmt.Println("3. Copy the signed file back to this node and submit it with `rocketpool node broadcast <signed file>`.")
+}
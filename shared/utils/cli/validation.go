@@ -252,3 +252,12 @@ func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
 	}
 	return pubkey, nil
 }
+
+// Validate a validator signature
+func ValidateSignature(name, value string) (types.ValidatorSignature, error) {
+	signature, err := types.HexToValidatorSignature(hexutils.RemovePrefix(value))
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+	return signature, nil
+}
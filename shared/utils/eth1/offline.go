@@ -0,0 +1,103 @@
+package eth1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/Seb369888/smartnode/shared/services"
+	"github.com/Seb369888/smartnode/shared/services/nonce"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+// Transaction options that build a transaction (including its nonce, gas limit and fees) without signing or submitting it
+type UnsignedTransactor struct {
+	Opts *bind.TransactOpts
+	tx   *types.Transaction
+}
+
+// Create unsigned transaction options from the node's regular transaction options for the provided chain
+func NewUnsignedTransactor(opts *bind.TransactOpts, chainID *big.Int) *UnsignedTransactor {
+	t := &UnsignedTransactor{}
+	unsignedOpts := *opts
+	unsignedOpts.NoSend = true
+	unsignedOpts.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		// Capture the transaction instead of signing it; the chain ID is normally set by the signer, so it has to be added here
+		t.tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+		return t.tx, nil
+	}
+	t.Opts = &unsignedOpts
+	return t
+}
+
+// Get the transaction that was built with these options, ready to be exported for offline signing
+func (t *UnsignedTransactor) GetOfflineTransaction(description string) (api.OfflineTransaction, error) {
+
+	if t.tx == nil {
+		return api.OfflineTransaction{}, errors.New("no transaction was built")
+	}
+	payload, err := t.tx.MarshalBinary()
+	if err != nil {
+		return api.OfflineTransaction{}, fmt.Errorf("error serializing unsigned transaction: %w", err)
+	}
+
+	offlineTx := api.OfflineTransaction{
+		Description: description,
+		ChainID:     (*hexutil.Big)(t.tx.ChainId()),
+		From:        t.Opts.From,
+		Nonce:       hexutil.Uint64(t.tx.Nonce()),
+		Gas:         hexutil.Uint64(t.tx.Gas()),
+		MaxFee:      (*hexutil.Big)(t.tx.GasFeeCap()),
+		MaxPrioFee:  (*hexutil.Big)(t.tx.GasTipCap()),
+		Value:       (*hexutil.Big)(t.tx.Value()),
+		Data:        t.tx.Data(),
+		Payload:     payload,
+	}
+	if t.tx.To() != nil {
+		offlineTx.To = *t.tx.To()
+	}
+	return offlineTx, nil
+
+}
+
+// Get transaction options that build an unsigned transaction from the node address without loading the node wallet.
+// Unless they were set explicitly, the Execution client provides the nonce, fees and gas limit.
+func GetUnsignedTransactor(c *cli.Context, nodeAddress common.Address) (*UnsignedTransactor, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.TransactOpts{
+		From:    nodeAddress,
+		Context: nonce.WithReservation(context.Background()),
+	}
+	if maxFee := c.GlobalFloat64("maxFee"); maxFee != 0 {
+		opts.GasFeeCap = eth.GweiToWei(maxFee)
+	}
+	if maxPriorityFee := c.GlobalFloat64("maxPrioFee"); maxPriorityFee != 0 {
+		opts.GasTipCap = eth.GweiToWei(maxPriorityFee)
+	}
+	err = CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+	return NewUnsignedTransactor(opts, big.NewInt(int64(cfg.Smartnode.GetChainID()))), nil
+}
@@ -0,0 +1,604 @@
+package rp
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/settings/protocol"
+	"github.com/Seb369888/poolsea-go/settings/trustednode"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+)
+
+// The kind of value a DAO setting holds, which determines how it's entered and how it's stored on-chain
+type DAOSettingType string
+
+const (
+	// true / false, stored as a bool
+	DAOSettingType_Bool DAOSettingType = "bool"
+
+	// A plain number, stored as-is
+	DAOSettingType_Count DAOSettingType = "count"
+
+	// A percentage from 0 to 100, stored as a fraction where 1e18 is 100%
+	DAOSettingType_Percent DAOSettingType = "percent"
+
+	// A ratio such as 1.5, stored as a fraction where 1e18 is 1
+	DAOSettingType_Ratio DAOSettingType = "ratio"
+
+	// A token amount (see Units), stored in wei
+	DAOSettingType_Token DAOSettingType = "token"
+
+	// A duration such as 1h30m45s, stored in seconds
+	DAOSettingType_Duration DAOSettingType = "duration"
+)
+
+// A DAO setting that can be changed with a proposal
+type DAOSetting struct {
+	// The name used to refer to the setting on the command line
+	Name string
+
+	// Alternative names for the setting
+	Aliases []string
+
+	// The settings contract the setting belongs to
+	ContractName string
+
+	// The setting's path within the contract
+	Path string
+
+	// The type of the setting's value
+	Type DAOSettingType
+
+	// The token of a token amount setting
+	Units string
+
+	// A description of the setting
+	Description string
+
+	// The inclusive range of on-chain values the setting accepts; nil means unbounded
+	Min *big.Int
+	Max *big.Int
+}
+
+// All of the Oracle DAO settings that can be changed with a proposal.
+// Adding a new setting only requires adding it here.
+var TNDAOSettings = []DAOSetting{
+	// Members
+	{
+		Name:         "members-quorum",
+		Aliases:      []string{"q"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.QuorumSettingPath,
+		Type:         DAOSettingType_Percent,
+		Description:  "The percentage of members that must vote on a proposal for it to pass",
+		Min:          eth.EthToWei(0.51),
+		Max:          eth.EthToWei(0.9),
+	},
+	{
+		Name:         "members-rplbond",
+		Aliases:      []string{"b"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.RPLBondSettingPath,
+		Type:         DAOSettingType_Token,
+		Units:        "RPL",
+		Description:  "The amount of RPL a new member must bond to join",
+	},
+	{
+		Name:         "members-minipool-unbonded-max",
+		Aliases:      []string{"u"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.MinipoolUnbondedMaxSettingPath,
+		Type:         DAOSettingType_Count,
+		Description:  "The maximum number of unbonded minipools a member can run",
+	},
+	{
+		Name:         "members-minipool-unbonded-min-fee",
+		Aliases:      []string{"f"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.MinipoolUnbondedMinFeeSettingPath,
+		Type:         DAOSettingType_Percent,
+		Description:  "The minimum commission rate before unbonded minipools are allowed",
+	},
+	{
+		Name:         "members-challenge-cooldown",
+		Aliases:      []string{"cc"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.ChallengeCooldownSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a member must wait before challenging another member again",
+	},
+	{
+		Name:         "members-challenge-window",
+		Aliases:      []string{"cw"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.ChallengeWindowSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a challenged member has to respond",
+	},
+	{
+		Name:         "members-challenge-cost",
+		Aliases:      []string{"cx"},
+		ContractName: trustednode.MembersSettingsContractName,
+		Path:         trustednode.ChallengeCostSettingPath,
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The fee a non-member must pay to challenge a member",
+	},
+
+	// Proposals
+	{
+		Name:         "proposal-cooldown",
+		Aliases:      []string{"c"},
+		ContractName: trustednode.ProposalsSettingsContractName,
+		Path:         trustednode.CooldownTimeSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a member must wait after making a proposal before making another",
+	},
+	{
+		Name:         "proposal-vote-timespan",
+		Aliases:      []string{"v"},
+		ContractName: trustednode.ProposalsSettingsContractName,
+		Path:         trustednode.VoteTimeSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a proposal can be voted on",
+	},
+	{
+		Name:         "proposal-vote-delay-timespan",
+		Aliases:      []string{"d"},
+		ContractName: trustednode.ProposalsSettingsContractName,
+		Path:         trustednode.VoteDelayTimeSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long after a proposal is made before voting on it starts",
+	},
+	{
+		Name:         "proposal-execute-timespan",
+		Aliases:      []string{"x"},
+		ContractName: trustednode.ProposalsSettingsContractName,
+		Path:         trustednode.ExecuteTimeSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a successful proposal can be executed for",
+	},
+	{
+		Name:         "proposal-action-timespan",
+		Aliases:      []string{"a"},
+		ContractName: trustednode.ProposalsSettingsContractName,
+		Path:         trustednode.ActionTimeSettingPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long an executed proposal's action (such as joining) can be performed for",
+	},
+
+	// Minipools
+	{
+		Name:         "scrub-period",
+		Aliases:      []string{"s"},
+		ContractName: trustednode.MinipoolSettingsContractName,
+		Path:         trustednode.ScrubPeriodPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long the scrub check lasts before a minipool can move from prelaunch to staking",
+	},
+	{
+		Name:         "promotion-scrub-period",
+		Aliases:      []string{"p"},
+		ContractName: trustednode.MinipoolSettingsContractName,
+		Path:         trustednode.PromotionScrubPeriodPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long the scrub check lasts before a vacant minipool can be promoted",
+	},
+	{
+		Name:         "scrub-penalty-enabled",
+		Aliases:      []string{"spe"},
+		ContractName: trustednode.MinipoolSettingsContractName,
+		Path:         trustednode.ScrubPenaltyEnabledPath,
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether scrubbed minipools are penalized",
+	},
+	{
+		Name:         "bond-reduction-window-start",
+		Aliases:      []string{"brws"},
+		ContractName: trustednode.MinipoolSettingsContractName,
+		Path:         trustednode.BondReductionWindowStartPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long after a bond reduction begins before it can be completed",
+	},
+	{
+		Name:         "bond-reduction-window-length",
+		Aliases:      []string{"brwl"},
+		ContractName: trustednode.MinipoolSettingsContractName,
+		Path:         trustednode.BondReductionWindowLengthPath,
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a bond reduction can be completed for once its window starts",
+	},
+}
+
+// All of the protocol DAO settings that can be viewed with `pdao settings`.
+// This network has no protocol DAO proposals, so they're read-only; adding a new setting only requires adding it here.
+var PDAOSettings = []DAOSetting{
+	// Auction
+	{
+		Name:         "auction-lot-create-enabled",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.lot.create.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether new RPL lots can be created",
+	},
+	{
+		Name:         "auction-lot-bidding-enabled",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.lot.bidding.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether RPL lots can be bid on",
+	},
+	{
+		Name:         "auction-lot-value-minimum",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.lot.value.minimum",
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The minimum value of a new lot",
+	},
+	{
+		Name:         "auction-lot-value-maximum",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.lot.value.maximum",
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The maximum value of a new lot",
+	},
+	{
+		Name:         "auction-lot-duration",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.lot.duration",
+		Type:         DAOSettingType_Count,
+		Description:  "How many blocks a lot stays open for bidding",
+	},
+	{
+		Name:         "auction-price-start",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.price.start",
+		Type:         DAOSettingType_Ratio,
+		Description:  "The starting price of a lot, relative to the RPL price",
+	},
+	{
+		Name:         "auction-price-reserve",
+		ContractName: protocol.AuctionSettingsContractName,
+		Path:         "auction.price.reserve",
+		Type:         DAOSettingType_Ratio,
+		Description:  "The reserve price of a lot, relative to the RPL price",
+	},
+
+	// Deposits
+	{
+		Name:         "deposit-enabled",
+		ContractName: protocol.DepositSettingsContractName,
+		Path:         "deposit.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether the deposit pool accepts deposits",
+	},
+	{
+		Name:         "deposit-assign-enabled",
+		ContractName: protocol.DepositSettingsContractName,
+		Path:         "deposit.assign.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether deposits are assigned to minipools",
+	},
+	{
+		Name:         "deposit-minimum",
+		ContractName: protocol.DepositSettingsContractName,
+		Path:         "deposit.minimum",
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The minimum deposit into the deposit pool",
+	},
+	{
+		Name:         "deposit-pool-maximum",
+		ContractName: protocol.DepositSettingsContractName,
+		Path:         "deposit.pool.maximum",
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The maximum size of the deposit pool",
+	},
+	{
+		Name:         "deposit-assign-maximum",
+		ContractName: protocol.DepositSettingsContractName,
+		Path:         "deposit.assign.maximum",
+		Type:         DAOSettingType_Count,
+		Description:  "The maximum number of minipools assigned per deposit",
+	},
+
+	// Inflation
+	{
+		Name:         "rpl-inflation-interval-rate",
+		ContractName: protocol.InflationSettingsContractName,
+		Path:         "rpl.inflation.interval.rate",
+		Type:         DAOSettingType_Ratio,
+		Description:  "The RPL inflation rate per interval",
+		Min:          eth.EthToWei(1),
+	},
+
+	// Minipools
+	{
+		Name:         "minipool-submit-withdrawable-enabled",
+		ContractName: protocol.MinipoolSettingsContractName,
+		Path:         "minipool.submit.withdrawable.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether minipools can be marked as withdrawable",
+	},
+	{
+		Name:         "minipool-launch-timeout",
+		ContractName: protocol.MinipoolSettingsContractName,
+		Path:         "minipool.launch.timeout",
+		Type:         DAOSettingType_Duration,
+		Description:  "How long a prelaunch minipool can wait before it times out",
+	},
+	{
+		Name:         "minipool-bond-reduction-enabled",
+		ContractName: protocol.MinipoolSettingsContractName,
+		Path:         "minipool.bond.reduction.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether minipool bonds can be reduced",
+	},
+
+	// Network
+	{
+		Name:         "network-consensus-threshold",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.consensus.threshold",
+		Type:         DAOSettingType_Percent,
+		Description:  "The percentage of oracle DAO members that must agree on a submission",
+		Min:          eth.EthToWei(0.51),
+	},
+	{
+		Name:         "network-submit-balances-enabled",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.submit.balances.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether network balances are submitted",
+	},
+	{
+		Name:         "network-submit-balances-frequency",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.submit.balances.frequency",
+		Type:         DAOSettingType_Count,
+		Description:  "How often network balances are submitted",
+	},
+	{
+		Name:         "network-submit-prices-enabled",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.submit.prices.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether network prices are submitted",
+	},
+	{
+		Name:         "network-submit-prices-frequency",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.submit.prices.frequency",
+		Type:         DAOSettingType_Count,
+		Description:  "How often network prices are submitted",
+	},
+	{
+		Name:         "network-node-fee-minimum",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.node.fee.minimum",
+		Type:         DAOSettingType_Percent,
+		Description:  "The minimum commission rate for new minipools",
+	},
+	{
+		Name:         "network-node-fee-target",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.node.fee.target",
+		Type:         DAOSettingType_Percent,
+		Description:  "The target commission rate for new minipools",
+	},
+	{
+		Name:         "network-node-fee-maximum",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.node.fee.maximum",
+		Type:         DAOSettingType_Percent,
+		Description:  "The maximum commission rate for new minipools",
+	},
+	{
+		Name:         "network-node-fee-demand-range",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.node.fee.demand.range",
+		Type:         DAOSettingType_Token,
+		Units:        "ETH",
+		Description:  "The deposit pool demand range over which the commission rate scales",
+	},
+	{
+		Name:         "network-reth-collateral-target",
+		ContractName: protocol.NetworkSettingsContractName,
+		Path:         "network.reth.collateral.target",
+		Type:         DAOSettingType_Percent,
+		Description:  "The target rETH collateralization rate",
+	},
+
+	// Nodes
+	{
+		Name:         "node-registration-enabled",
+		ContractName: protocol.NodeSettingsContractName,
+		Path:         "node.registration.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether new nodes can register",
+	},
+	{
+		Name:         "node-deposit-enabled",
+		ContractName: protocol.NodeSettingsContractName,
+		Path:         "node.deposit.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether nodes can create new minipools",
+	},
+	{
+		Name:         "node-vacant-minipools-enabled",
+		ContractName: protocol.NodeSettingsContractName,
+		Path:         "node.vacant.minipools.enabled",
+		Type:         DAOSettingType_Bool,
+		Description:  "Whether nodes can create vacant minipools for solo staker migrations",
+	},
+	{
+		Name:         "node-per-minipool-stake-minimum",
+		ContractName: protocol.NodeSettingsContractName,
+		Path:         "node.per.minipool.stake.minimum",
+		Type:         DAOSettingType_Percent,
+		Description:  "The minimum RPL stake per minipool, as a percentage of the borrowed ETH",
+	},
+	{
+		Name:         "node-per-minipool-stake-maximum",
+		ContractName: protocol.NodeSettingsContractName,
+		Path:         "node.per.minipool.stake.maximum",
+		Type:         DAOSettingType_Percent,
+		Description:  "The maximum effective RPL stake per minipool, as a percentage of the bonded ETH",
+	},
+}
+
+// Get an Oracle DAO setting by its name, one of its aliases, or its path
+func GetTNDAOSetting(name string) (DAOSetting, error) {
+	setting, exists := findDAOSetting(TNDAOSettings, name)
+	if !exists {
+		return DAOSetting{}, fmt.Errorf("Unknown Oracle DAO setting '%s'", name)
+	}
+	return setting, nil
+}
+
+// Get a protocol DAO setting by its name, one of its aliases, or its path
+func GetPDAOSetting(name string) (DAOSetting, error) {
+	setting, exists := findDAOSetting(PDAOSettings, name)
+	if !exists {
+		return DAOSetting{}, fmt.Errorf("Unknown protocol DAO setting '%s'", name)
+	}
+	return setting, nil
+}
+
+// Find a setting by its name, one of its aliases, or its path
+func findDAOSetting(settings []DAOSetting, name string) (DAOSetting, bool) {
+	for _, setting := range settings {
+		if setting.Name == name || setting.Path == name {
+			return setting, true
+		}
+		for _, alias := range setting.Aliases {
+			if alias == name {
+				return setting, true
+			}
+		}
+	}
+	return DAOSetting{}, false
+}
+
+// Get a description of the values the setting accepts
+func (s DAOSetting) GetFormatDescription() string {
+	switch s.Type {
+	case DAOSettingType_Bool:
+		return "true / false"
+	case DAOSettingType_Count:
+		return "a number (e.g. 100)"
+	case DAOSettingType_Percent:
+		return "a percent, from 0 to 100"
+	case DAOSettingType_Ratio:
+		return "a ratio (e.g. 1.5)"
+	case DAOSettingType_Token:
+		return fmt.Sprintf("an amount of %s (e.g. 5000)", s.Units)
+	case DAOSettingType_Duration:
+		return "a duration (e.g. 1h30m45s)"
+	default:
+		return string(s.Type)
+	}
+}
+
+// Parse a value entered by the user into the setting's on-chain value.
+// Bools are represented as 1 (true) or 0 (false).
+func (s DAOSetting) ParseValue(input string) (*big.Int, error) {
+
+	var value *big.Int
+	switch s.Type {
+	case DAOSettingType_Bool:
+		boolValue, err := strconv.ParseBool(strings.ToLower(input))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s value '%s' - valid values are 'true' and 'false'", s.Name, input)
+		}
+		value = big.NewInt(0)
+		if boolValue {
+			value.SetUint64(1)
+		}
+
+	case DAOSettingType_Count:
+		count, err := strconv.ParseUint(input, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s value '%s' - must be a non-negative integer", s.Name, input)
+		}
+		value = new(big.Int).SetUint64(count)
+
+	case DAOSettingType_Percent:
+		percent, err := strconv.ParseFloat(input, 64)
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("Invalid %s value '%s' - must be a number between 0 and 100", s.Name, input)
+		}
+		value = eth.EthToWei(percent / 100)
+
+	case DAOSettingType_Ratio:
+		ratio, err := strconv.ParseFloat(input, 64)
+		if err != nil || ratio < 0 {
+			return nil, fmt.Errorf("Invalid %s value '%s' - must be a non-negative number", s.Name, input)
+		}
+		value = eth.EthToWei(ratio)
+
+	case DAOSettingType_Token:
+		amount, err := strconv.ParseFloat(input, 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("Invalid %s value '%s' - must be a non-negative amount of %s", s.Name, input, s.Units)
+		}
+		value = eth.EthToWei(amount)
+
+	case DAOSettingType_Duration:
+		duration, err := time.ParseDuration(input)
+		if err != nil || duration < 0 {
+			return nil, fmt.Errorf("Invalid %s value '%s' - must be a duration such as 1h30m45s", s.Name, input)
+		}
+		value = new(big.Int).SetUint64(uint64(duration.Seconds()))
+
+	default:
+		return nil, fmt.Errorf("Setting %s has unknown type '%s'", s.Name, s.Type)
+	}
+
+	if err := s.ValidateValue(value); err != nil {
+		return nil, err
+	}
+	return value, nil
+
+}
+
+// Check that an on-chain value is allowed for the setting
+func (s DAOSetting) ValidateValue(value *big.Int) error {
+	if value.Sign() < 0 {
+		return fmt.Errorf("Invalid %s value %s - must not be negative", s.Name, s.FormatValue(value))
+	}
+	if s.Type == DAOSettingType_Bool && value.Cmp(big.NewInt(1)) > 0 {
+		return fmt.Errorf("Invalid %s value %s - must be 0 (false) or 1 (true)", s.Name, value.String())
+	}
+	if s.Min != nil && value.Cmp(s.Min) < 0 {
+		return fmt.Errorf("Invalid %s value %s - must be at least %s", s.Name, s.FormatValue(value), s.FormatValue(s.Min))
+	}
+	if s.Max != nil && value.Cmp(s.Max) > 0 {
+		return fmt.Errorf("Invalid %s value %s - must be at most %s", s.Name, s.FormatValue(value), s.FormatValue(s.Max))
+	}
+	return nil
+}
+
+// Format an on-chain value of the setting for display
+func (s DAOSetting) FormatValue(value *big.Int) string {
+	switch s.Type {
+	case DAOSettingType_Bool:
+		return strconv.FormatBool(value.Sign() != 0)
+	case DAOSettingType_Percent:
+		return fmt.Sprintf("%.2f%%", eth.WeiToEth(value)*100)
+	case DAOSettingType_Ratio:
+		return fmt.Sprintf("%.6f", eth.WeiToEth(value))
+	case DAOSettingType_Token:
+		return fmt.Sprintf("%.6f %s", eth.WeiToEth(value), s.Units)
+	case DAOSettingType_Duration:
+		return (time.Duration(value.Uint64()) * time.Second).String()
+	default:
+		return value.String()
+	}
+}
@@ -0,0 +1,138 @@
+package rp
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Seb369888/poolsea-go/rocketpool"
+	"github.com/Seb369888/poolsea-go/utils/eth"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Deposit pool contract and event used to measure deposit inflow
+const (
+	depositPoolContractName  = "poolseaDepositPool"
+	depositReceivedEventName = "DepositReceived"
+)
+
+// Estimates longer than this are reported as unknown instead of overflowing a time.Duration
+const maxQueueWaitTime = 10 * 365 * 24 * time.Hour
+
+// An estimate of when a queued minipool will be assigned
+type QueueEstimate struct {
+	// The ETH that has to be deposited into the deposit pool before the minipool is assigned, including its own share
+	EthRequired *big.Int `json:"ethRequired"`
+
+	// The part of EthRequired that isn't already in the deposit pool
+	Shortfall *big.Int `json:"shortfall"`
+
+	// The estimated time until the shortfall has been deposited; only valid if Known is true
+	TimeToAssignment time.Duration `json:"timeToAssignment"`
+
+	// False if there have been too few deposits over the lookback period to make an estimate
+	Known bool `json:"known"`
+}
+
+// Get the average rate (in wei per second) at which ETH was deposited into the deposit pool over the lookback period
+func GetDepositPoolInflowRate(rp *rocketpool.RocketPool, lookback time.Duration, secondsPerBlock uint64, intervalSize *big.Int) (*big.Int, error) {
+
+	// Get the block range to scan
+	latestBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the latest block number: %w", err)
+	}
+	if secondsPerBlock == 0 {
+		return nil, fmt.Errorf("the number of seconds per block cannot be 0")
+	}
+	lookbackBlocks := uint64(lookback.Seconds()) / secondsPerBlock
+	fromBlock := uint64(0)
+	if latestBlock > lookbackBlocks {
+		fromBlock = latestBlock - lookbackBlocks
+	}
+
+	// Get the deposit events
+	depositPool, err := rp.GetContract(depositPoolContractName, nil)
+	if err != nil {
+		return nil, err
+	}
+	event, exists := depositPool.ABI.Events[depositReceivedEventName]
+	if !exists {
+		return nil, fmt.Errorf("the deposit pool contract does not have a %s event", depositReceivedEventName)
+	}
+	query := eth.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(latestBlock),
+		Topics:    [][]common.Hash{{event.ID}},
+	}
+	logs, err := eth.FilterContractLogs(rp, depositPoolContractName, query, intervalSize, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get deposit pool deposit events: %w", err)
+	}
+
+	// Add up the deposits
+	total := big.NewInt(0)
+	for _, log := range logs {
+		deposit := struct {
+			Amount *big.Int
+			Time   *big.Int
+		}{}
+		if err := depositPool.ABI.UnpackIntoInterface(&deposit, depositReceivedEventName, log.Data); err != nil {
+			return nil, fmt.Errorf("Could not decode deposit event in transaction %s: %w", log.TxHash.Hex(), err)
+		}
+		total.Add(total, deposit.Amount)
+	}
+
+	// Get the rate
+	seconds := int64((latestBlock - fromBlock) * secondsPerBlock)
+	if seconds == 0 {
+		return big.NewInt(0), nil
+	}
+	return total.Quo(total, big.NewInt(seconds)), nil
+
+}
+
+// Estimate how long a minipool at the given queue position (1-indexed) will wait before it is assigned.
+// Every minipool in the queue is assumed to need the queue's average capacity.
+func EstimateQueueWaitTime(position int64, queueLength *big.Int, queueCapacity *big.Int, depositPoolBalance *big.Int, inflowRate *big.Int) QueueEstimate {
+
+	// Get the ETH required to assign every minipool up to and including this one
+	ethRequired := big.NewInt(0)
+	if position > 0 && queueLength.Sign() > 0 {
+		ethRequired.Mul(queueCapacity, big.NewInt(position))
+		ethRequired.Quo(ethRequired, queueLength)
+	}
+
+	// Check if the deposit pool can already cover it
+	shortfall := big.NewInt(0).Sub(ethRequired, depositPoolBalance)
+	if shortfall.Sign() <= 0 {
+		return QueueEstimate{
+			EthRequired: ethRequired,
+			Shortfall:   big.NewInt(0),
+			Known:       true,
+		}
+	}
+
+	// Estimate the time until the shortfall is deposited
+	if inflowRate == nil || inflowRate.Sign() == 0 {
+		return QueueEstimate{
+			EthRequired: ethRequired,
+			Shortfall:   shortfall,
+		}
+	}
+	seconds := big.NewInt(0).Quo(shortfall, inflowRate)
+	if seconds.Cmp(big.NewInt(int64(maxQueueWaitTime.Seconds()))) > 0 {
+		return QueueEstimate{
+			EthRequired: ethRequired,
+			Shortfall:   shortfall,
+		}
+	}
+	return QueueEstimate{
+		EthRequired:      ethRequired,
+		Shortfall:        shortfall,
+		TimeToAssignment: time.Duration(seconds.Int64()) * time.Second,
+		Known:            true,
+	}
+
+}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Seb369888/poolsea-go/types"
+	"github.com/Seb369888/smartnode/shared/services/config"
+	"github.com/Seb369888/smartnode/shared/services/wallet"
+	"github.com/Seb369888/smartnode/shared/types/api"
+)
+
+// Find the wallet keys for the provided validator pubkeys by deriving them in buckets.
+// Pubkeys that aren't found within the search limit are left out of the result.
+func FindValidatorKeys(w *wallet.Wallet, pubkeys []types.ValidatorPubkey) (map[types.ValidatorPubkey]wallet.ValidatorKey, error) {
+
+	pending := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range pubkeys {
+		pending[pubkey] = true
+	}
+
+	found := map[types.ValidatorPubkey]wallet.ValidatorKey{}
+	err := deriveValidatorKeys(w, pending, func(validatorKey wallet.ValidatorKey) error {
+		found[validatorKey.PublicKey] = validatorKey
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+
+}
+
+// Get the pubkeys of the custom keystores in the node's custom key folder, without decrypting them.
+// Files that aren't keystores are skipped and returned separately so they can be reported.
+func GetCustomKeyPubkeys(cfg *config.RocketPoolConfig) (map[types.ValidatorPubkey]bool, []string, error) {
+
+	pubkeys := map[types.ValidatorPubkey]bool{}
+	skipped := []string{}
+	customKeyDir := cfg.Smartnode.GetCustomKeyPath()
+	info, err := os.Stat(customKeyDir)
+	if os.IsNotExist(err) || !info.IsDir() {
+		return pubkeys, skipped, nil
+	}
+
+	// Get the custom keystore files
+	files, err := os.ReadDir(customKeyDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error enumerating custom keystores: %w", err)
+	}
+	zeroPubkey := types.ValidatorPubkey{}
+	for _, file := range files {
+		if file.IsDir() {
+			skipped = append(skipped, file.Name())
+			continue
+		}
+		bytes, err := os.ReadFile(filepath.Join(customKeyDir, file.Name()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading custom keystore %s: %w", file.Name(), err)
+		}
+		keystore := api.ValidatorKeystore{}
+		if err := json.Unmarshal(bytes, &keystore); err != nil || keystore.Pubkey == zeroPubkey {
+			skipped = append(skipped, file.Name())
+			continue
+		}
+		pubkeys[keystore.Pubkey] = true
+	}
+
+	return pubkeys, skipped, nil
+
+}
@@ -62,11 +62,32 @@ func RecoverMinipoolKeys(c *cli.Context, rp *rocketpool.RocketPool, address comm
 	}
 
 	// Recover conventionally generated keys
-	bucketStart := uint(0)
-	for {
-		if bucketStart >= bucketLimit {
-			return nil, fmt.Errorf("attempt limit exceeded (%d keys)", bucketLimit)
+	err = deriveValidatorKeys(w, pubkeyMap, func(validatorKey wallet.ValidatorKey) error {
+		if testOnly {
+			return nil
 		}
+		err := w.SaveValidatorKey(validatorKey)
+		if err != nil {
+			return fmt.Errorf("error recovering validator keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pubkeyMap) > 0 {
+		return nil, fmt.Errorf("attempt limit exceeded (%d keys)", bucketLimit)
+	}
+
+	return pubkeys, nil
+
+}
+
+// Derive the wallet's validator keys in buckets until every pubkey in the map has been found or the search limit is reached.
+// Each key that's found is removed from the map and passed to the handler.
+func deriveValidatorKeys(w *wallet.Wallet, pubkeyMap map[types.ValidatorPubkey]bool, handler func(validatorKey wallet.ValidatorKey) error) error {
+
+	for bucketStart := uint(0); bucketStart < bucketLimit && len(pubkeyMap) > 0; bucketStart += bucketSize {
 		bucketEnd := bucketStart + bucketSize
 		if bucketEnd > bucketLimit {
 			bucketEnd = bucketLimit
@@ -75,32 +96,21 @@ func RecoverMinipoolKeys(c *cli.Context, rp *rocketpool.RocketPool, address comm
 		// Get the keys for this bucket
 		keys, err := w.GetValidatorKeys(bucketStart, bucketEnd-bucketStart)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		for _, validatorKey := range keys {
 			_, exists := pubkeyMap[validatorKey.PublicKey]
 			if exists {
 				// Found one!
 				delete(pubkeyMap, validatorKey.PublicKey)
-				if !testOnly {
-					err := w.SaveValidatorKey(validatorKey)
-					if err != nil {
-						return nil, fmt.Errorf("error recovering validator keys: %w", err)
-					}
+				if err := handler(validatorKey); err != nil {
+					return err
 				}
 			}
 		}
-
-		if len(pubkeyMap) == 0 {
-			// All keys recovered!
-			break
-		}
-
-		// Run another iteration with the next bucket
-		bucketStart = bucketEnd
 	}
 
-	return pubkeys, nil
+	return nil
 
 }
 